@@ -61,7 +61,7 @@ func (fi bindata_file_info) Sys() interface{} {
 	return nil
 }
 
-var __2_0_schema_json = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x5d\x6d\x73\xdb\xb8\xf1\x7f\x7f\x9f\x02\xa3\xcb\x8c\x2f\x13\x5b\xca\xe5\xff\x7f\xd3\x74\x3a\x37\xee\x39\xbd\xba\x4d\x6a\x4f\x9c\xb4\x2f\x6c\x65\x06\x22\x21\x0b\x77\x14\xa9\x10\xa4\x6d\x5d\xea\xef\xde\xc5\x03\x29\x82\x04\x48\xf0\x41\x8e\x7d\xe1\xcd\x9c\xed\x48\xc0\x62\x77\xb1\xd8\xfd\xed\x02\x04\xbf\x7c\x87\xd0\x24\xa1\x49\x40\x26\xaf\xd1\xe4\x18\xfd\xe3\xe2\xec\x5f\xe8\xc2\x5b\x91\x35\x46\xcb\x28\x46\x17\xb7\xf8\xfa\x9a\xc4\xe8\xd5\xf4\x25\x3a\x3e\x3f\x9d\x4e\x0e\x79\x07\xea\xf3\xd6\xab\x24\xd9\xbc\x9e\xcd\x98\x6c\x32\xa5\xd1\xec\xe6\xd5\x8c\x89\xbe\xd3\x5f\x59\x14\x7e\x2f\x1b\x3f\x93\x1f\x15\x7a\xf0\x2f\x8f\x54\xc3\x28\xbe\x9e\xf9\x31\x5e\x26\x47\x2f\xff\x5f\x75\x56\xfd\x92\xed\x46\x30\x15\x2d\x7e\x25\x5e\x22\x3f\x8b\xc9\xe7\x94\xc6\x84\x0f\x7f\x39\x51\x03\x4f\x0e\x81\xa1\x70\x19\xf1\xdf\x1b\x9c\xac\xd8\x64\x2e\xda\x62\xdf\xa7\x09\x8d\x42\x1c\x9c\xc7\xd1\x86\xc4\x09\x25\x0c\xfa\x2d\x71\xc0\x88\x68\x00\x8d\x13\x12\x87\xda\xb7\x5c\x1f\xf0\xd5\xa7\xbb\xa3\xfc\x1f\x5c\x84\x98\x2c\x39\x2b\xdf\xcf\x7c\xb2\xa4\xa1\x20\xcb\x66\x37\x24\xf4\xa3\xf8\xcd\x5d\x42\x42\x06\x1f\x4c\x44\xeb\x7b\xf8\x79\x2f\xc9\x1b\xe8\x66\x2c\x17\x68\x67\x62\xb2\x24\xa6\xe1\xb5\x10\x53\x7c\x4e\xc2\x74\x2d\xc4\x04\xd5\x4b\x89\xc4\xc7\x3e\x61\x5e\x4c\x37\x9c\x03\xde\xeb\xc3\x8a\xe4\x73\x74\x43\x62\xce\x07\x8a\x96\x28\x59\x51\x86\xfc\xc8\x4b\xd7\x24\x4c\xa6\x8a\x33\x49\x43\xea\xaa\x51\x38\xd1\x4a\xeb\xb7\x8a\x58\xe2\xc2\x38\x98\xcd\x1a\xf3\x96\x93\x34\xa6\xbb\x8f\x95\xb6\xf9\xe7\x9f\x2e\x3f\x7d\xb9\x9f\xa1\xd7\x57\xf0\xdf\xfc\xc5\x0f\x3f\xbd\x86\xbf\xfc\x17\xcf\x7f\x7a\x36\xa9\x13\x73\x99\x06\xc1\x16\x7d\x4e\x71\x40\x97\x94\xf8\xe8\xe3\xfb\x53\x94\x44\x20\x2a\x41\x9c\x35\x29\x36\x91\x56\xaa\x31\xbe\xc0\x8c\x9c\x83\x61\xb8\x30\x5f\xe4\x72\x56\xcb\x0e\xa7\x8a\xb8\xbd\x65\x4c\xf0\x81\xd1\x9b\x3b\xbc\xde\x04\xe4\x35\x3a\x98\xe1\x0d\x3d\x28\x71\x22\xec\xbb\x60\x0f\x56\xed\xab\x86\x6f\x29\xa8\x5c\xa3\xe0\xc1\xb7\x69\x89\x44\x89\xb9\x63\x14\x50\xa9\x8e\x77\xa7\xef\xde\x20\x2e\x29\x43\xd8\xf3\xc8\x26\x01\xad\x2d\xb6\x3b\x2d\x1d\xd6\x33\xb1\x26\x3e\xc5\x1f\xa0\x7b\x95\x0d\xb0\x6d\x3f\xf5\xda\xb3\xa1\x86\x46\x1e\x0e\x91\xa2\xd1\x8b\x0d\xb1\xdc\x1b\xb5\x29\x9b\x69\x3d\x0b\x5f\x37\xf7\x2f\x36\x2e\x8d\x1f\xe3\x35\x01\x83\x71\x62\x42\xb5\x3d\xb1\x51\x8b\x09\xdb\xc0\x87\x2e\xf6\x91\x35\xb5\xd2\x62\xc4\x83\xd5\x97\x6c\x1d\x4c\x2d\x6b\x69\xec\x7f\xd2\x46\x4f\xa6\x4e\x1a\xd5\x04\x5f\x33\xd3\x2a\xc4\x71\x8c\xb7\x3b\x3b\xa0\x09\x59\x17\xdb\x59\x07\x04\x7a\x13\xd5\xe6\x3e\xef\x9d\x86\xf4\x73\x4a\x4e\x15\x8d\x24\x4e\x89\xc6\x03\xb9\xe3\x0b\x1c\x07\x27\x91\xe7\x20\x92\xd6\xba\xe4\xe0\x4d\x36\x54\xf1\xae\x86\x28\x66\x5a\x2d\xbf\x90\x90\xc4\x38\x40\xbc\x3b\x77\x9f\xdc\x8f\xe3\x45\x94\x26\x86\xd5\xaa\x05\x41\xe5\xf4\x79\xf0\x93\x61\x7c\x17\x2a\x9a\x02\x60\xb6\x84\x2c\x41\x50\x7c\xad\x07\xc2\x1a\x45\x19\x83\x61\xa6\x2f\x7d\x82\x0c\x81\x51\xaa\x4a\xc1\x10\x6d\x34\x8b\xa3\x36\xe9\xf0\x18\xc9\xa9\x47\x38\xf4\xc1\xbb\x10\x8f\x82\x87\x16\x44\xab\xb1\x41\xe3\x08\x48\x65\x6a\xec\x33\x3a\x03\xec\x12\x26\xd4\xcb\x03\x31\x44\xf0\x05\xc4\xe5\xc6\xc1\x75\x4a\xdd\x19\x08\xa2\x90\xe3\x80\xc2\xe7\xc5\xb1\xd1\xc5\x2a\x4a\x03\x88\x00\x04\xf9\x74\xb9\x24\x31\x40\x03\xb4\x8c\xa3\xb5\x68\x21\xf4\x34\x45\xe8\x17\x9a\xac\xd2\xc5\xd1\x32\xc0\x37\x11\xd8\x18\x5a\xe3\xf8\x37\x3f\xba\x0d\x11\x00\x0a\x1c\x04\xd1\x2d\xf1\x2d\x52\x80\x19\xad\xd9\xd9\xf2\x82\xc4\x37\xd4\xeb\x33\x8f\x3c\xba\x0a\x62\x9c\x7b\x26\xc9\x09\x30\x5a\xaf\x45\x08\x8d\x09\xf6\x12\x37\x73\xcd\x1a\x1b\x29\x05\x30\x20\x38\x57\x37\x4a\x59\xe3\xaa\xc1\x97\x03\x77\x89\x3b\x57\xd7\xf0\xb3\xec\xa9\xb9\x86\x4c\x1b\x30\x31\x60\x6b\x9a\x85\xb5\x5c\xfe\x96\xb5\x18\x42\xac\xea\x39\x85\xd4\x07\x03\xa3\xcb\x2d\xb4\x45\x9c\x5c\xc6\xa5\xd2\x04\x82\x71\x21\x0f\x98\x41\x02\x80\x43\xfa\xbb\x90\xcb\x32\xb3\x69\x1c\xf4\xe4\xe5\xe3\xfb\xb7\x68\x13\x51\xe0\x07\x98\x51\x78\xcd\xab\xea\x75\xaa\x13\xd2\x91\xac\x91\x35\x58\xf2\xb4\x2f\x73\x82\x06\x82\xe9\x82\xa8\xce\x9c\xb4\x64\xe1\x52\x32\xd3\x60\x88\x55\xe3\xb6\x1a\xa2\x16\x68\x84\x45\xb4\x0d\x2e\xfb\xb3\xae\xa2\x45\x29\x91\x04\xc6\x9c\xa2\xd3\xe4\x80\x21\x12\x7a\x51\x1a\xe3\x6b\xf0\x60\x30\xdd\x29\xe3\x41\x01\x9d\x5d\x00\xf2\x8c\xd6\x10\xf2\xe8\x22\xc8\xbb\x3d\xa8\xd1\xe5\x63\x3a\x19\x9a\x71\x02\x2b\xa0\xd7\xd1\x8f\xbc\x27\x01\x08\x7e\x23\x93\x16\x96\x31\x44\x43\x9f\xde\x50\x1f\xb2\x2a\xd0\x99\x2f\xd8\x65\x53\x04\xec\x6f\xd1\x3a\x05\xfc\x0e\xd1\x22\xce\x3a\xaa\x2e\x07\x59\x42\x75\x30\xad\x24\x4e\x7b\xc4\x11\x85\xb9\x81\xd4\xcc\x89\x18\x97\x94\x03\xc1\x3a\x34\x52\x67\xc8\x2e\x29\x83\x4d\xfb\x16\xba\x8d\x98\x56\x55\x4b\x2a\x7c\x96\x66\xf3\x2c\x04\xf3\x8f\xd1\x1a\x82\xb4\x2c\xda\xc8\xf1\x99\x8a\xfe\x0b\x61\x73\x30\x59\x92\x1c\x83\x79\xe4\x9f\xa8\xf4\xd1\x57\x10\x49\x24\x60\x7a\x4e\x68\xce\x6f\x2c\x69\xc0\xd0\xb2\xe7\xe3\xb5\x17\x3f\x26\x80\xf8\x18\x44\x1c\xe1\x22\x99\x88\x90\x85\xf4\xcc\x98\x68\x3d\x90\x54\xd9\x70\xfb\x15\xca\x96\xd7\xb4\x94\x46\x77\xe0\x25\x06\xab\xd9\x49\x36\x6a\x5e\x6c\x12\x5f\x5a\x42\x08\x77\xa9\xf3\xc6\xe0\xe0\x8e\x86\x87\xf0\xdb\xad\x5c\x2f\x91\xd5\x1d\x27\xfd\x36\x39\xc4\x4b\x7c\xf4\xfb\xcb\xa3\x3f\x1d\xcd\x5f\xcc\xd4\x9f\x57\x57\x47\x2f\xe6\x2f\x9e\xf1\x76\x3d\xdc\xd4\x9a\xae\xc9\x07\xc9\x53\xab\x6a\xd7\xe5\xd5\x15\xdb\xf1\xf1\xe7\xab\xab\xe9\x5f\xae\xae\x66\x9c\x9f\xba\x2a\x58\x5e\xdb\xc9\x62\xf1\xdf\x3f\x7c\x38\x47\x6b\x00\x32\x10\x7b\x4b\xde\x84\xb3\x8d\x4b\xd3\xea\x86\x3d\x76\x95\x91\x27\x9c\xdd\xea\xb5\x0f\x4d\x78\xbd\xfe\x21\xbe\xaa\xd6\x40\x4c\x33\x59\xf8\xf2\x5e\xeb\x6e\x29\x82\x94\x1a\x4e\x20\x18\x40\x82\xb7\xed\x95\x75\x2e\x62\x4a\x20\x4f\x93\x94\x32\x33\xc8\xe7\xfa\xab\xa5\xbc\x39\x07\x87\xe8\xba\x73\x4e\x6b\x71\xaa\xe2\x3b\xd7\x82\x51\x99\x66\xce\xd8\xa9\xdf\x4b\xf4\x25\xe8\x3d\xf4\x83\xad\x06\x85\x77\x6b\xcc\x38\xb6\xa1\x6e\x6b\xd1\x6b\xcb\xda\x6d\x8d\x46\x0c\xf5\xdb\x32\x5b\x86\xaa\x76\x27\xb6\x14\x9d\xa1\xd8\x32\x96\x77\x6b\x48\xee\xda\xdb\x69\x9a\x8a\xbc\x35\x24\x77\xcd\xcd\xeb\xb7\xb2\xa1\x50\x43\xab\xb2\xa9\x50\xa6\xe6\x73\xb8\xe1\xe1\x84\x58\x0d\x73\x11\x45\x01\xc1\x61\xd9\x32\x97\x38\x0d\x12\x2d\x1a\x55\x18\xad\xd6\xa3\xeb\x38\xd5\x6a\xd2\x82\x96\x35\x15\x12\xf8\x7e\x28\xbc\xf3\x88\xe2\x85\x22\xdc\x1a\xff\x5c\x13\xc7\x12\x58\x93\xab\x48\x07\xa2\xa3\x6f\x1b\x76\x27\xe4\x93\x00\xd6\xd6\x20\xa4\xa2\x4d\x19\xf4\x77\xa7\xb5\x22\xb8\xb2\x5c\xba\x29\x0a\x27\xde\x6a\x20\x4a\x03\xf9\x2d\xe3\xa2\x33\x6e\x53\x39\xd7\x20\x64\xdf\x3c\x5b\xe5\xb1\x8b\x09\xdf\x4d\x20\x48\x43\x24\x5f\xf0\x4a\xcd\x16\xdd\xe0\x80\xfa\x12\x4c\x32\xc8\x29\x52\x68\x13\xf9\x22\x3b\x3a\x50\xee\xa6\x58\x7c\x58\x53\x7d\xc9\xfe\x38\xec\xaa\xff\xe1\x12\x50\xf1\xfc\xcb\xff\xdd\x3f\x7f\xf6\xdf\x4f\x3f\xa8\xf1\x9f\x3f\x6b\xe7\xc1\xff\x8d\x83\x94\x58\xca\x19\x7b\x70\x2b\x61\x94\x94\xf0\xa7\x79\x86\x1c\x75\xd4\xa8\x25\xa3\x18\xed\x05\xd9\x89\xd2\x64\x7e\x52\x9f\x05\x13\x8c\x42\x72\xc6\x87\xba\xec\x90\x90\x37\x27\xf1\xfc\xcc\xca\x7b\x22\x76\x4d\xbc\x5d\xc7\xb9\x91\xb5\xd6\xf9\x4d\x71\x95\xec\x3d\x41\xce\x8f\xe3\xb8\x42\x06\x6c\x77\x7c\xce\x2e\x26\x6b\x6c\x41\xd9\x95\xb4\xba\x86\x54\xde\xba\xc6\xfa\x9d\xb3\xe5\xaa\x10\x83\x97\x7f\xe4\x10\x13\xa3\x31\xab\xef\x86\x82\x2f\x9a\x51\x09\x4a\xcd\xd6\x94\xe8\xb5\x02\x8d\x09\x53\x1e\x92\x9f\x4b\xca\xbe\x04\x5f\x23\xf6\x39\xe5\x39\xac\x84\xa8\x23\x59\x39\x54\xcc\xd2\xdb\xb9\x71\xee\xf3\xfa\x4b\x6b\x3b\x36\x65\xc8\xb6\x00\x17\xd3\x35\xe5\x25\x6c\x26\x33\x62\x23\x3d\x2f\x0a\x02\x50\x39\x74\xf8\x9b\x91\x27\xdb\x46\x62\xa9\x97\x05\xbb\x64\x10\xd9\x81\x64\xd6\xd8\x48\x69\x8d\xef\xe8\x3a\x5d\xbb\x51\xca\x1a\x5b\x56\x9d\x17\xa4\x0c\x94\xf2\xae\x0d\xc9\x4a\x2f\x33\x97\xd0\xde\x9d\x4b\xd5\xb8\x81\xcb\x36\x24\x2b\xbd\x6c\xba\x7c\x4b\xc2\xeb\xc4\x11\x75\xed\x9a\xdb\x64\x6e\x45\x2d\x6f\x6e\x43\x83\xaa\x34\xe7\xb6\xcf\x21\x1a\xdb\xa4\x3c\x75\x5f\x2a\x79\x6b\x9b\x8c\x6d\x68\x65\xad\x8d\xb4\xf4\x12\x95\x03\xb9\x62\x07\xb3\xad\x84\xce\xf6\x11\x5a\x6d\x02\x56\x1e\x85\xf0\x72\x56\x49\xbe\x2c\x32\xee\xda\x5b\x56\x7e\xfb\x60\x6d\x0c\x15\x65\xc8\x54\x73\xf2\x0e\xf0\xb3\xf2\xf6\x5b\x8e\x9e\x63\xb1\x01\x79\x0b\xf8\x1a\xdd\x1d\xf1\x9a\x97\x00\xd7\xcd\x27\x05\x78\xd5\xd0\xd0\xc6\x7a\xb2\x6a\x11\xf9\xdb\xf3\x7c\x07\xa7\xd3\xfe\xb2\x08\x21\xfc\xa7\x02\x1e\xf3\xc7\x98\x99\x0f\x55\xbb\x94\x85\x53\x43\xe9\x32\xcf\xc7\xf8\x46\x2c\xe5\xa9\x0f\x3f\xb7\x23\x4e\x04\x50\x48\x94\x14\xfe\xe1\xad\x53\xd6\xef\xcc\xce\xc0\x1b\xf1\x3b\xc6\x2d\x21\xbb\x8f\xc2\x4e\x38\x61\x70\x29\xaa\xe6\x17\x44\x1e\x36\x2b\xcd\x82\x57\xb8\x79\x5a\x40\x48\xc1\x10\xdb\x94\xbd\x6c\xec\xdd\xae\x88\xc8\x65\x21\x5b\x85\x34\x4c\x1e\xc6\xce\xd9\xe3\x93\x92\x8d\xc7\x5b\xc8\x5a\x04\x0e\xa6\x1d\x8a\x6a\x7d\x90\x7c\x5f\xcc\x9c\xaf\xf4\x8b\x74\x71\x51\x66\xe4\xc9\x55\xdb\x9e\xa8\x05\x7c\xd5\x05\xa5\x92\x17\xf3\x92\x1a\x9d\x64\x57\x27\x39\x68\x42\x56\xc8\xc2\x0a\xb9\xd9\x98\x90\x8d\x09\x59\x1d\x97\x63\x42\x56\xa5\x36\x26\x64\x4f\x38\x21\x33\x66\x54\xc0\x7b\xbc\x1d\x61\xcc\xb7\x0e\x63\x84\x19\x8c\x28\x66\x44\x31\xb2\xcd\xe3\x44\x31\xff\x81\x79\x7f\xc7\x3d\xdb\x08\x67\xd0\x08\x67\x46\x38\x33\xc2\x19\x54\x86\x33\xdc\xe5\x9d\xe0\x04\x8f\x88\xe6\x5b\x47\x34\x99\x25\x8c\xa0\xe6\xa9\x82\x1a\xf8\x63\x49\xf9\xc3\xdc\x23\xb8\x19\xc1\x4d\x3d\x97\x23\xb8\xa9\x52\x1b\xc1\xcd\x1f\x0d\xdc\xf0\x93\xde\x23\xb0\xc9\x03\x4a\xe1\x33\xbe\x0d\x3f\xdf\x2f\xfa\x79\x7c\x08\x87\x9b\xc3\x88\x6e\x9e\x2a\xba\xf9\x36\x50\xcd\x08\x66\xd0\x08\x66\x46\x30\x33\x82\x19\x54\x06\x33\x61\x14\xfe\x75\xc0\xb3\x72\xa5\x83\xde\xee\xcf\x41\x58\x0f\xf2\xe4\x20\x06\x35\xd2\xa8\xa9\x3a\xb5\xa0\x62\xdb\x8a\x6b\x41\xc2\x82\x10\x33\x0a\xf3\x12\x9c\x34\xa8\xde\x5d\x71\xfa\x49\xc7\x16\x4c\x56\x66\xde\xcc\x5e\xe5\x60\x97\xeb\xf3\x55\xc7\x00\x58\x24\xb2\xa2\xac\x78\x13\x19\x20\x00\xac\xdd\xed\x2a\xe9\x3c\xe8\xfd\x2d\xce\xc8\xb8\xeb\x93\x8f\xdd\x01\x84\xf1\xe6\xb9\x4c\x3e\xc7\x4b\x6b\x67\x3b\x71\x66\x92\x5e\x5b\x78\xda\x63\xc0\x22\xd5\x2e\xb0\xa3\xd3\x90\x75\xd8\xa4\xd1\x77\x76\x18\xb1\xe9\xa8\x75\x03\x20\xea\x32\xe2\x10\xa8\xa9\xc3\xb8\x83\x40\xab\x2e\xf2\x0e\x81\xbf\x7a\xc9\xdb\x0b\xa4\xb9\x8e\xac\x85\x8d\x88\x89\x7c\xe0\x54\x25\x29\xdd\x00\xdd\x00\x23\x9f\xc8\xf5\xf4\xb2\x13\x08\xec\xa0\xf3\x5e\x48\x71\x9f\x9a\xde\xf7\xc0\xf5\x8a\x76\xc0\xa2\x1d\x94\xdd\x08\x58\x41\xe1\xf6\x07\x6b\x1f\x42\xeb\x0f\x32\x7a\xbd\xea\x6d\x15\xb1\x3e\x0c\xc8\xa8\x7f\x2c\xca\x0e\xad\x33\x85\x2e\x9e\xcc\x9a\x4e\x98\xaa\x2b\x7d\x20\x06\x27\x67\x1c\xc8\x58\x0d\xc1\xe1\xb6\x8c\x6d\x35\x0e\xea\x1f\x16\x28\x81\xdb\xa2\x3c\x86\xbb\x8b\xf4\x65\xfc\x63\xe9\x2b\xf3\xc5\x46\x2d\x58\x41\x85\x47\xc4\x39\x72\xb6\xec\xcd\x7e\xb9\x37\x6a\x07\x07\x41\x15\x94\xd4\xdc\xc3\x64\x93\xc4\x76\x41\x93\xb3\x42\xcd\x6e\xde\xba\x0a\xad\x8f\xef\x23\x87\x27\x93\xdb\x71\xd6\x4e\xa1\x3e\xe5\xd0\x13\xd4\x84\x93\x28\xee\x02\xbe\x63\xc8\x42\xcf\xc2\xc0\x7a\x09\x55\xe7\x3b\x67\xee\xd6\x95\x9b\xe0\xcc\x3a\xe0\x0d\x2d\x90\x67\xf8\xeb\x9f\x54\x81\xb8\xa8\xd0\xd2\x56\x47\xa9\x44\xe9\x90\x05\xf6\xba\x8c\x75\x7c\x00\x5c\x75\x1d\xcb\xbe\x63\xd9\xb7\x49\x9b\x63\xd9\x77\x2c\xfb\x76\x2d\xfb\x9a\xde\xc9\xd1\xf7\x0d\x18\x19\xcd\xf7\x12\x3b\xf3\x1b\x58\x77\xf5\xc8\xef\x8c\x8a\xae\x3c\xb7\x6d\xa2\xd1\x3d\xe8\x54\x23\x4b\x05\x56\x99\x3d\xb7\xd3\xe5\xae\xb6\x6b\x2d\x75\x4d\xeb\x91\x7f\x3f\x61\xd3\x61\x73\xd6\x28\x82\xb8\xf1\x6a\x83\x1b\xdf\x90\x60\xc1\x87\x30\xf7\x77\x5d\x7a\x82\x83\x88\xe9\x22\xad\x5e\xa0\xd6\x1b\x66\xdd\xc6\x78\xb3\x19\xea\xca\x40\xe3\x74\xf2\xd7\xbc\x0c\x35\x9d\x75\x77\xda\x0f\x3d\xd5\x3d\x2f\x6a\x1a\x0c\x7d\x56\x1c\xc2\xc3\x15\xfb\x8d\x8e\xe6\x64\x47\x62\x18\x47\x63\xd8\xb9\xb1\xf2\xbc\xc0\x8c\x7a\xc7\x69\xb2\xe2\x6f\xa7\x90\xc7\x4e\x2e\xaa\xd7\x4d\x1e\xba\x88\x8f\x37\xf4\x9f\x64\xdb\xb5\x77\x84\x81\x89\x57\xa7\x90\x0c\x50\x8f\x26\xfd\xa8\x9c\x63\xc6\x6e\xa3\xd8\xef\x47\xe5\x78\xc3\x79\xe9\xa5\x12\x45\xc8\xf3\x08\x63\x3f\x47\x3e\x31\xd0\x99\x1b\x6d\xa3\x6e\x5a\xf6\xb2\xf6\xf7\x7d\x3d\x96\x10\xa8\xf7\x19\xa5\xc7\xb7\x88\x4b\x46\xbf\xbf\xb9\x39\x44\xc5\x7d\xee\x3d\xce\x94\x94\xc8\x32\x55\x5d\x7d\x7f\xbb\xe3\x71\xe5\x3b\x15\x0e\xd1\x40\x8f\x25\x3e\x3e\xfb\xb1\xb8\xbd\xbd\xda\xd1\x32\x88\x6e\x45\x1d\x02\x86\x8e\x62\xf5\x1a\x9d\x8f\x4e\x6f\x23\xe8\x6c\x55\x52\x4e\x5b\xd1\x83\x33\xd4\x85\x2a\x55\x7a\xb3\xd0\x65\x1e\x48\xe1\x7a\xaf\xad\x60\xf0\x42\xf6\x30\x63\xc6\xb2\xba\x5a\x70\xec\xf0\xee\xa4\xa7\x6f\xc5\x95\xb0\xfb\x20\x56\x9c\x44\xbf\x91\xa7\x69\xbd\x1b\xa5\xaf\x07\xb1\xde\x5c\x4d\xa3\xd5\xea\x56\x6b\x82\x79\xa3\xe1\x36\x40\x84\x5c\x65\xa3\xed\x7e\x55\xdb\xad\x66\x16\x5f\x07\x39\x3c\x6d\x73\xce\xb5\xf8\x47\xc0\x11\xe3\x62\x41\xe6\xc5\x72\x51\x9e\xc5\x01\x6a\xb9\xba\xc8\xfa\xa8\xfa\x2b\x55\x06\xac\x72\xe7\xef\xb4\xaa\xe8\xb7\xa1\xb4\x5d\x7a\xb3\x41\x33\x4b\x86\x47\x68\x76\x34\x50\x48\x88\x2f\xdf\x28\xc9\x60\x3e\x10\x56\xaf\x29\x90\xaf\x9f\x09\x02\xfd\x8d\x04\x95\x63\x03\x86\x3b\x54\x75\x0f\x64\xd0\x47\x9b\xca\x56\xe5\xed\x79\xae\x45\x1b\xe3\xdd\xf6\xc5\x4a\x8d\xf3\x4e\x42\xe1\x0d\x33\x9d\x74\x9d\xc4\x38\x64\xc0\x08\xbf\xbf\x36\x89\xbc\x28\x30\xbf\xda\xd6\xa0\x28\xeb\x62\x2f\xe4\xd6\x49\xb2\xe1\x7e\x9b\xff\x66\xfc\x8f\x5b\xf5\x93\x4d\x5a\x4b\x5a\xb3\x57\x6c\x63\x65\xc7\x88\xc7\x6e\xc4\x1d\xb7\xf2\x57\x22\x7f\x6d\xe8\x46\x7b\xbf\xd9\xae\x44\x2e\xda\xd7\x8e\xbe\x7b\x80\x7a\x20\x36\x0e\xd5\x3e\x98\x23\x3f\xe5\xf3\xd3\x03\x9d\x9d\xce\xc8\x9b\x3d\xf1\xa0\xe7\xa5\x77\x43\x95\xf7\xe9\x07\x3b\x23\x9d\x7b\x49\xd3\x06\xe3\x90\xe7\xa2\xf3\x81\x2a\xdb\xfa\x83\x9d\x85\xce\x86\xa8\x39\x42\x30\xfc\xf9\xe7\x5c\xae\xca\x41\x80\xc1\xce\x3c\x57\xe5\x1a\x74\x2c\xf3\xa9\x84\xc2\x7c\x55\xce\x0f\x0c\x7f\xf6\xb3\xa0\xc5\xbd\x8e\xa6\x9f\xf5\xdc\x05\xe4\xf2\xa9\x86\xc1\xce\x30\x17\xd4\x58\x39\x38\xb5\x4f\x2d\xee\x73\x30\xb3\x12\xcd\x07\x27\x06\x3d\x9f\x9c\x2f\x84\x70\x38\xe3\x0f\xcb\x06\xaf\xe3\x8e\x7d\x6f\xd8\xb7\x7a\xac\x48\xc7\xb7\xdf\xf1\xff\xef\xff\x17\x00\x00\xff\xff\xf5\xe4\x24\xa1\x2c\x8b\x00\x00")
+var __2_0_schema_json = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xed\x5d\x6d\x73\xdc\xb6\x11\xfe\x9e\x5f\x81\xb9\x78\x46\xf6\x58\xba\x73\xdc\x7e\xa9\x3b\x9d\x8c\x1a\xb9\xa9\x52\xbb\xd2\x58\x76\xfb\xc1\x3a\xcf\xe0\x48\x9c\x0e\x09\x8f\xa4\x09\x52\xd2\xc5\xd5\x7f\xef\xe2\x85\x3c\x82\x04\x8e\xe0\xcb\xc9\x52\xcc\xcc\x58\x52\x48\x60\xb1\x58\x2c\x76\x9f\x5d\x80\xc0\x97\xef\x10\x9a\xa4\x34\x0d\xc8\xe4\x15\x9a\x1c\xa3\x5f\x2e\xce\xfe\x8d\x2e\xbc\x15\x59\x63\xb4\x8c\x12\x74\x71\x83\xaf\xae\x48\x82\x5e\x4e\x5f\xa0\xe3\xf3\xd3\xe9\xe4\x90\x57\xa0\x3e\x2f\xbd\x4a\xd3\xf8\xd5\x6c\xc6\x64\x91\x29\x8d\x66\xd7\x2f\x67\x4c\xd4\x9d\xfe\xca\xa2\xf0\x7b\x59\xf8\x89\x7c\x54\xaa\xc1\x5f\x1e\xa9\x82\x51\x72\x35\xf3\x13\xbc\x4c\x8f\x5e\xfc\x59\x55\x56\xf5\xd2\x4d\x2c\x98\x8a\x16\xbf\x12\x2f\x95\xcf\x12\xf2\x39\xa3\x09\xe1\xcd\x7f\x9c\xa8\x86\x27\x87\xc0\x50\xb8\x8c\xf8\xef\x18\xa7\x2b\x36\x99\x8b\xb2\xd8\xf7\x69\x4a\xa3\x10\x07\xe7\x49\x14\x93\x24\xa5\x84\x41\xbd\x25\x0e\x18\x11\x05\xa0\x70\x4a\x92\x50\x7b\xfb\x05\x5e\xc0\xab\x4f\xb7\x47\xc5\xff\xf0\x2e\x24\x64\xc9\x59\xf9\x7e\xe6\x93\x25\x0d\x05\x59\x36\xbb\x26\xa1\x1f\x25\xaf\x6f\x53\x12\x32\x78\x30\x11\xa5\xef\xe0\xe7\x9d\x24\x6f\xa0\x9b\xb3\x5c\xa2\x9d\x77\x93\xa5\x09\x0d\xaf\x44\x37\xc5\x73\x12\x66\x6b\xd1\x4d\x10\xbd\xec\x91\x78\xec\x13\xe6\x25\x34\xe6\x1c\xf0\x5a\xef\x57\xa4\x18\xa3\x6b\x92\x70\x3e\x50\xb4\x44\xe9\x8a\x32\xe4\x47\x5e\xb6\x26\x61\x3a\x55\x9c\x49\x1a\x52\x56\x8d\x9d\x13\xa5\xb4\x7a\xab\x88\xa5\x2e\x8c\x2b\xb1\xf2\x57\x9f\x3e\x7e\xfa\x72\x37\x43\xaf\x2e\xe1\xbf\xf9\xf3\xa7\x3f\xbe\x82\xbf\xfc\xe7\xcf\x7e\x7c\x32\xd9\xd5\x9f\x65\x16\x04\x1b\xf4\x39\xc3\x01\x5d\x52\xe2\xa3\x0f\xef\x4e\x51\x1a\x41\x9f\x08\xe2\x3c\xc8\xfe\x11\xa9\x8e\x1a\x87\x0b\xcc\xc8\x39\x68\x40\x5b\x2e\x67\x3b\xd9\xe1\x54\x11\x57\xac\x9c\x09\xde\x30\x7a\x7d\x8b\xd7\x71\x40\x5e\xa1\x83\x19\x8e\xe9\x41\x85\x13\xa1\xc8\xa5\x81\xb7\x8a\x59\x15\x7c\x43\x41\xb6\x1a\x05\x0f\xde\x66\x15\x12\x15\xe6\x8e\x51\x40\xa5\x38\xde\x9e\xbe\x7d\x8d\x78\x4f\x19\xc2\x9e\x47\xe2\x14\xa4\xb6\xd8\x6c\xa5\x74\xb8\x9b\x89\x35\xf1\x29\x7e\x0f\xd5\xeb\x6c\x80\x12\xfb\x99\xd7\x9e\x0d\xd5\x34\xf2\x70\x88\x14\x8d\x5e\x6c\x88\x79\xdd\x28\x4d\x59\x4c\xab\x59\x7a\xdd\x5c\xbf\x5c\xb8\xd2\x7e\x82\xd7\x04\x14\xc6\x89\x09\x55\xf6\xc4\x46\x2d\x21\x2c\x86\x87\x2e\xfa\x91\x17\xb5\xd2\x62\xc4\xcb\x12\x9a\x6e\x1c\x54\x2d\x2f\x69\xac\x7f\xd2\x46\x4e\xa6\x4a\x1a\xd5\x14\x5f\x31\xd3\x2c\xc4\x49\x82\x37\x5b\x3d\xa0\x29\x59\x97\xcb\x59\x1b\x04\x7a\x13\x55\xe6\xae\xa8\x9d\x85\xf4\x73\x46\x4e\x15\x8d\x34\xc9\x88\xc6\x03\xb9\xe5\x13\x1c\x07\x27\x91\xe7\xd0\x25\xad\x74\xc5\x92\x9b\x74\xa8\x66\x46\x0d\xee\xca\x34\x5b\x7e\x26\x21\x49\x70\x80\x78\xf5\x64\x8d\xf9\x63\x84\x17\x51\x96\x1a\x66\xab\xe6\xed\x94\x75\xe7\x5e\x4e\xfa\xeb\xad\x4f\x68\xf2\x74\x65\x83\x67\xf0\x76\x06\x8f\xd7\xd6\xeb\xe5\xf2\xd2\x07\x28\xb6\xb4\x95\xe3\x0d\xad\x35\x8b\xa1\x36\x5b\x1c\x39\xf4\x08\x87\x3e\x58\x17\xe2\x51\xb0\xd0\x82\x68\xdd\x37\x68\x1c\x01\xa9\x5c\x8c\x7d\x5a\x67\x00\x52\xc2\x94\x7a\x85\xc7\x05\x57\xbd\x00\x07\xdc\xd8\xb8\x4e\xa9\x3b\x03\x41\x14\x72\x87\x5f\x7a\x5e\x6e\x1b\x5d\xac\xa2\x2c\x00\x0f\x40\x90\x4f\x97\x4b\x92\x00\x06\x40\xcb\x24\x5a\x8b\x12\x42\x4e\x53\x84\x7e\xa6\xe9\x2a\x5b\x1c\x2d\x03\x7c\x1d\x81\x8e\xa1\x35\x4e\x7e\xf3\xa3\x9b\x10\x01\x72\xc0\x41\x10\xdd\x10\xdf\xd2\x0b\x50\xa3\x35\x3b\x5b\x5e\x90\xe4\x9a\x7a\x7d\xc6\x91\x7b\x57\x41\x8c\x73\xcf\x24\x39\x81\x3a\x77\x4b\x11\x5c\x63\x8a\xbd\xd4\x4d\x5d\xf3\xc2\x46\x4a\x01\x34\x08\xc6\xd5\x8d\x52\x5e\xb8\xae\xf0\x55\xc7\x5d\xe1\xce\xd5\x34\xfc\x24\x6b\x6a\xa6\x21\x97\x06\x0c\x0c\xe8\x9a\xa6\x61\x2d\xa7\xbf\x65\x2e\x86\xe0\xab\x7a\x0e\x21\xf5\x41\xc1\xe8\x72\x03\x65\x11\x27\x97\x73\xa9\x24\x81\xa0\x5d\x00\xfc\x33\x40\xfa\x38\xa4\xbf\x8b\x7e\x59\x46\x36\x4b\x82\x9e\xbc\x7c\x78\xf7\x06\xc5\x11\x05\x7e\x80\x19\x85\xd7\xbc\xba\x5c\xa7\x3a\x21\xf9\x9c\xd3\x00\xb7\x66\x66\x0d\xa6\x3c\xed\xcb\x9c\xa0\x81\x60\xb8\xc0\xab\x33\x27\x29\x59\xb8\x94\xcc\x34\x28\x62\x5d\xb9\xad\x8a\xa8\x39\x1a\xa1\x11\xf3\x07\xa3\x5d\x65\x8d\x52\x5d\x12\x18\x73\x8a\x4e\xd3\x03\x86\x48\xe8\x45\x59\x82\xaf\xc0\x82\xc1\x70\x67\x8c\x3b\x05\x74\x76\x01\xc8\x33\x5a\x83\xcb\xa3\x8b\xa0\xa8\x76\xaf\x4a\x57\xb4\xe9\xa4\x68\xc6\x01\xac\x81\x5e\x47\x3b\xf2\x8e\x04\xd0\xf1\x6b\x19\xb4\xb0\x9c\x21\x1a\xfa\xf4\x9a\xfa\x10\x55\x81\xcc\x7c\xc1\x2e\x9b\x22\x60\x7f\x83\xd6\x19\xe0\x77\xf0\x16\x49\x5e\x51\x55\x39\xc8\x03\xaa\x83\xe9\xe4\x1e\x71\x44\x69\x6c\x20\x34\x73\x22\xc6\x7b\xca\x81\xe0\x2e\x34\xb2\x4b\x91\x5d\x42\x06\x9b\xf4\x2d\x74\x1b\x31\xad\x4a\x8b\xd4\xf8\xac\x8c\xe6\x59\x08\xea\x9f\xa0\x35\x38\x69\x99\x9d\x91\xed\x33\xe5\xfd\x17\x42\xe7\x60\xb0\x24\x39\x06\xe3\xc8\x9f\xa8\xf0\xd1\x57\x10\x49\x04\x60\x7a\x4c\x68\x8e\x6f\x4e\xee\xa7\xef\x45\x7b\xed\xbb\x9f\x10\x40\x7c\x0c\x3c\x8e\x30\x91\x4c\x78\xc8\x52\x78\x66\x0c\xb4\xee\xa9\x57\x79\x73\xfb\xed\x94\x2d\xae\x69\xd9\x1b\xdd\x80\x57\x18\xac\x47\x27\x79\xab\x45\x56\x49\xbc\xb4\xb8\x10\x6e\x52\xe7\x8d\xce\xc1\x1d\x0d\x0f\x61\xb7\x5b\x99\x5e\x22\xb3\x3b\x4e\xf2\x6d\x32\x88\x1f\xf1\xd1\xef\x2f\x8e\xfe\x72\x34\x7f\x3e\x53\x7f\x5e\x5e\x1e\x3d\x9f\x3f\x7f\xc2\xcb\xf5\x30\x53\x6b\xba\x26\xef\x25\x4f\xed\x72\x72\x97\x97\x6c\xcb\xc7\x5f\x2f\x2f\xa7\x7f\xbb\xbc\x9c\x71\x7e\x76\x65\xc1\x8a\xdc\x4e\xee\x8b\xff\xf9\xfe\xfd\x39\x5a\x03\x90\x01\xdf\x5b\xb1\x26\x9c\x6d\x5c\x19\x56\x37\xec\xb1\xcd\x8c\x3c\xe2\xe8\x56\xcf\x7d\xec\xc8\x7f\x58\x72\x20\x3b\x67\x80\x36\x07\xec\x49\x90\xea\x64\x01\x67\x00\x01\xde\xa6\x57\xd4\xb9\x48\x28\x81\x38\x4d\x52\xca\xd5\xa0\x18\xeb\xaf\x16\xf2\x16\x1c\x1c\xa2\xab\xce\x31\xad\xc5\xa8\xb6\x4a\x18\x55\x69\x16\x8c\x9d\xfa\xbd\xba\xbe\x04\xb9\x87\x7e\xb0\xd1\xa0\xf0\x76\x8e\x19\xdb\x36\xe4\x6d\x87\xc9\xdd\xb6\xcb\xdf\x1a\x42\xf7\x6a\x56\xbb\x13\x5b\x8a\xce\x50\x6c\x19\xd3\xbb\x2e\xf0\x85\xd9\x69\x9a\x92\xbc\x0e\xd8\xc1\xa2\x4b\xf5\x05\x85\x36\x8b\x0a\xf5\x49\xc9\xb3\x66\x38\x25\x56\xc5\x5c\x44\x51\x40\x70\x58\xd5\xcc\x25\xce\x82\x54\xf3\x46\x35\x46\xeb\xf9\x68\xe7\x9c\xf4\xee\x50\x48\xe0\xfb\xa1\xf0\xce\x03\xf2\x17\x8a\x70\x6b\xfc\x73\x45\x1c\x53\x60\x4d\xa6\x22\x1b\x88\x8e\xbe\x3e\xd8\x9d\x90\x4f\x02\x98\x5b\x83\x90\x8a\xe2\x2a\xe8\xef\x4e\x6b\x45\xb0\x3f\x8c\xa0\x70\xea\xad\x06\xa2\x34\x90\xdd\x32\x4e\x3a\xe3\x32\x95\x73\x0e\x42\xd6\x2d\xa2\x55\xee\xbb\x98\xb0\xdd\x04\x9c\x34\x78\xf2\x05\xcf\xd4\x6c\xd0\x35\x0e\xa8\x2f\xc1\x24\x83\x98\x22\x83\x32\x91\x2f\xa2\xa3\x03\x65\x6e\xca\xc9\x87\x35\xd5\xa7\xec\x0f\xc3\xce\xfa\xa7\x1f\x01\x15\xcf\xbf\xfc\xe9\xee\xd9\x93\xff\x7d\x7a\xaa\xda\x7f\xf6\xa4\x9d\x05\xff\x0f\x0e\x32\x62\x49\x67\xec\xc1\xac\x84\x51\x5a\xc1\x9f\xe6\x11\x72\x94\x51\xa3\x94\x8c\xdd\x68\xdf\x91\x6d\x57\x9a\xd4\x4f\xca\xb3\xa4\x82\x51\x48\xce\x78\x53\x1f\x3b\x04\xe4\xcd\x41\x3c\xdf\x9c\xf2\x8e\x88\x55\x13\x6f\x5b\x71\x6e\x64\xad\x75\x7c\x53\x9e\x25\x7b\x0f\x90\x8b\x7d\x37\xae\x90\x01\xdb\x0d\x9f\xb3\x89\xc9\x0b\x5b\x50\x76\x2d\xac\xde\x89\xb0\x55\xe9\x21\x92\x7a\xf5\x4e\x0c\x9e\xfe\x91\x4d\x4c\x8c\xca\xac\xde\x0d\x05\x5f\x34\xa5\x12\x94\x9a\xb5\x29\xd5\x73\x05\x8d\x71\x48\xb1\x01\x29\x7f\x09\xb6\x46\xac\x73\xca\x0d\x57\x29\x51\x7b\xaf\x0a\xa8\x98\x87\xb7\x73\xe3\xd8\x17\xf9\x97\xd6\x7a\x6c\x8a\x90\x6d\x0e\x2e\xa1\x6b\xca\x53\xd8\x4c\x46\xc4\x96\x20\x24\x08\x40\xe4\x50\xe1\x1f\x46\x9e\x6c\x0b\x89\x95\x5a\x16\xec\x92\x43\x64\x07\x92\x79\x61\x23\xa5\x35\xbe\xa5\xeb\x6c\xed\x46\x29\x2f\x6c\x99\x75\x5e\x90\x31\x10\xca\xdb\x36\x24\x6b\xb5\xcc\x5c\x42\x79\x77\x2e\x55\xe1\x06\x2e\xdb\x90\xac\xd5\xb2\xc9\xf2\x0d\x09\xaf\xd2\x95\xb3\x34\x55\x71\x5b\x9f\x5b\x51\x2b\x8a\xdb\xd0\xa0\x4a\xcd\xb9\xad\x73\x88\xc2\xb6\x5e\x9e\xba\x4f\x95\xa2\xb4\xad\x8f\x6d\x68\xe5\xa5\xcd\x89\x5a\x2d\x45\xe5\x40\xae\x5c\xc1\xac\x2b\xa1\xb3\x7e\x84\x56\x9d\x80\x99\x47\xc1\xbd\x9c\x2d\x1d\xfb\xb8\x2d\xdf\x33\xd1\xd5\x80\x7b\xaa\x90\x69\xc7\xce\x3b\xc0\xcf\xca\xda\x6f\x38\x7a\x4e\xc4\x02\xe4\x0d\xe0\x6b\x74\x7b\xc4\x73\x5e\x02\x5c\x37\xef\x14\xe0\x59\x43\x43\x19\xeb\xce\xaa\x45\xe4\x6f\xce\x8b\x15\x9c\x4e\xeb\xcb\xc2\x85\xf0\x9f\x0a\x78\xcc\x1f\x62\x64\x3e\x54\xee\x52\x26\x4e\x0d\xa9\xcb\x22\x1e\xe3\x0b\xb1\x94\x87\x3e\x7c\xdf\x8e\xd8\x11\x40\x21\x50\x52\xf8\x87\x97\xce\x58\xbf\x3d\x3b\x03\x2f\xc4\x6f\x19\xb7\xb8\xec\x3e\x02\x3b\xe1\x84\xc1\xa4\xa8\x9c\x5f\x10\x79\xd8\x2c\x34\x0b\x5e\xe1\xea\x69\x01\x21\x25\x45\x6c\x93\xf6\xb2\xb1\x77\xb3\x22\x22\x96\x85\x68\x15\xc2\x30\xb9\xeb\xba\x60\x8f\x0f\x4a\xde\x1e\x2f\x21\x73\x11\x38\x98\x76\x48\xaa\xf5\x41\xf2\x7d\x31\x73\x31\xd3\x2f\xb2\xc5\x45\x95\x91\x47\x97\x6d\x7b\xa4\x1a\xf0\x55\x27\x94\x0a\x5e\xe6\x7b\x5d\xe0\xf9\xf6\x8c\xe4\xa0\x01\x59\x29\x0a\x2b\xc5\x66\x63\x40\x36\x06\x64\x63\x40\x36\x06\x64\xdf\x4c\x40\x66\x8c\xa8\x80\xf7\x64\x33\xc2\x98\x6f\x1d\xc6\x08\x35\x18\x51\xcc\x88\x62\x1e\x32\x8a\xf9\x2f\x8c\xfb\x5b\x6e\xd9\x46\x38\x33\xc2\x99\x11\xce\x8c\x70\xa6\x0e\x67\xb8\xc9\x3b\xc1\x29\x1e\x11\xcd\xb7\x8e\x68\x72\x4d\x18\x41\xcd\x63\x05\x35\xf0\xc7\x92\xf2\x8f\xb9\x47\x70\x33\x82\x9b\x11\xdc\x8c\xe0\xe6\x5b\x07\x37\x7c\xa7\xf7\x08\x6c\x0a\x87\x52\x7a\xc6\x97\xe1\xe7\xfb\x45\x3f\x0f\x0f\xe1\x70\x75\x18\xd1\xcd\x98\xb2\x19\x17\x9e\x46\x30\x33\x82\x99\x11\xcc\x3c\x2e\x30\x13\x46\xe1\xdf\x07\xdc\x2b\x57\xd9\xe8\xed\xfe\x1d\x84\x75\x23\x4f\x8b\xef\x22\x76\x64\x9d\x5a\x50\xb1\x2d\xc5\xb5\x20\x61\x41\x88\xe6\x2f\x35\x62\x93\xe8\xdd\x05\xa7\xef\x74\x6c\xc1\x64\x6d\xe4\xcd\xec\xd5\x36\x76\xb9\x7e\x5f\x75\x0c\x80\x45\x22\x2b\xca\xca\x27\x91\x01\x02\xc0\xda\x21\xae\x92\xce\xbd\x9e\xdf\xb2\xf7\x2f\x1f\xbb\x03\x08\xe3\xc9\x73\x79\xff\x1c\x4f\xa7\x9d\x6d\xbb\x33\x93\xf4\xda\xc2\xd3\x1e\x0d\x96\xa9\x76\x81\x1d\x9d\x9a\xdc\x85\x4d\x1a\x6d\x67\x87\x16\x9b\xb6\x5a\x37\x00\xa2\x2e\x2d\x0e\x81\x9a\x3a\xb4\x3b\x08\xb4\xea\xd2\xdf\x21\xf0\x57\xaf\xfe\xf6\x02\x69\xae\x2d\x6b\x6e\x23\x62\x22\x1e\x38\x55\x41\x4a\x37\x40\x37\x40\xcb\x27\x72\x3e\xbd\xe8\x04\x02\x3b\xc8\xbc\x17\x52\xdc\xa7\xa4\xf7\xdd\xf0\x6e\x41\x3b\x60\xd1\x0e\xc2\x6e\x04\xac\x20\x70\xfb\x87\xb5\xf7\x21\xf5\x7b\x69\x7d\xb7\xe8\x6d\x19\xb1\x3e\x0c\x48\xaf\x7f\x2c\xd2\x0e\xad\x23\x85\x2e\x96\xcc\x1a\x4e\x98\xb2\x2b\x7d\x20\x06\x27\xe7\x9e\x0d\xc1\xe1\xa6\x8a\x6d\x5b\x7c\x2c\x50\x01\xb7\x1a\xae\xaa\x9f\x5d\xa4\x4f\xe3\x1f\x2a\xaf\xcc\x07\x1b\xb5\x60\xa5\xfc\x89\x38\x47\xce\x96\xb5\xd9\x2f\x77\x46\xe9\xe0\x20\x38\x5b\xb6\x38\x87\xc9\xd6\x13\xdb\x01\x4d\xce\x02\xb5\x9d\xcf\x63\x99\x85\xd6\xcf\xf7\x5d\xbe\x4c\x6e\x39\xd4\xad\x04\xea\x53\x0e\x3d\x41\x4c\x38\x8d\x92\x2e\xe0\x3b\x81\x28\xf4\x2c\x0c\x36\x83\x9f\x39\x73\xbb\x0e\xdc\x62\x16\x5e\xf0\xde\x8e\x7f\x52\x09\x62\xed\x94\xb7\xca\xd9\xfa\x7a\x8a\x72\xb0\xb3\x6d\xc6\x0f\xc0\xc7\xb4\xef\x98\xf6\x1d\xd3\xbe\x63\xda\xf7\xeb\xa4\x7d\x4d\x77\x72\xf4\xbd\x01\x23\xa7\xf9\x4e\x62\x67\x7e\x02\x6b\xeb\x1b\x31\x4c\x34\x06\x39\x0f\xc5\x06\xab\xcc\x96\xdb\xe9\x70\x57\xdb\xb1\x96\xba\xa4\x75\xcf\xbf\x1f\xb7\xe9\xb0\x38\x6b\x5d\xd4\x65\x31\xf6\x3a\x55\x8e\x41\x09\xe8\x6d\x97\x9a\x60\x20\x12\xba\xc8\x52\x32\x38\xcc\xba\x49\x70\x1c\x0f\x75\x64\xa0\x71\x38\xf9\x35\x2f\x7b\x39\x22\xa7\x72\xa6\xfd\xd0\x43\xdd\xf3\xa0\xa6\xc1\xd0\x67\x3d\xf3\x7e\x6f\xc9\xfe\x36\x97\x0a\x75\x37\x34\x86\x95\x1b\xfb\xda\x0d\x66\xd4\x3b\xce\xd2\x15\xbf\x9d\x42\x6e\x3b\xb9\xa8\x1f\x37\x79\xe8\xd2\x7d\x1c\xd3\x7f\x91\x4d\xd7\xda\x11\x06\x26\x5e\x9e\x42\x30\x40\x3d\x9a\xf6\xa3\x72\x8e\x19\xbb\x89\x12\xbf\x1f\x95\xe3\x98\xf3\xd2\x4b\x24\x8a\x90\xe7\x11\xc6\x7e\x8a\x7c\x62\xa0\x33\x37\xea\xc6\xae\x61\x79\x94\xc7\x63\x89\x0e\xf5\xde\xa3\xf4\xf0\x26\x71\x45\xe9\xf7\x37\x36\x87\xa8\xbc\xce\xbd\xc7\x91\x92\x3d\xb2\x0c\x55\x57\xdb\xdf\x6e\x7b\x5c\xf5\x4c\x85\x43\x34\xd0\x67\x89\x0f\x4f\x7f\x2c\x66\x6f\xaf\x7a\xb4\x0c\xa2\x1b\x91\x87\x80\xa6\xa3\x44\x5d\xa3\xf3\xc1\xe9\x36\x82\xce\x5a\x25\xfb\x69\x4b\x7a\x70\x86\xba\x50\xa5\x4a\x6e\x16\xba\xcc\x83\x5e\xb8\x9e\x6b\x2b\x18\xbc\x90\x35\xcc\x98\xb1\x2a\xae\x01\xee\x55\xf8\x43\x69\x71\xcd\xed\xde\x8b\x16\xa7\xd1\x6f\xe4\x71\x6a\x6f\xac\xe4\x75\x2f\xda\x5b\x88\x69\xd4\x5a\x5d\x6b\x4d\x30\x6f\x54\xdc\x06\x88\x50\x88\x6c\xd4\xdd\xaf\xaa\xbb\xf5\xc8\xe2\xeb\x20\x87\xc7\xad\xce\x85\x14\xff\x08\x38\x62\x9c\x2c\x96\xc9\x72\x51\x1d\xc5\x01\x72\xb9\x7a\x97\xf5\x56\xf5\x2b\x55\x06\xcc\x72\x17\x77\x5a\xb5\x4d\x6d\x57\x6e\x36\x68\x66\xc9\xf0\x09\xcd\x96\x06\x0a\x09\xf1\xe5\x8d\x92\x0c\xc6\x03\x61\x75\x4d\x81\xbc\x7e\x26\x08\xf4\x1b\x09\x6a\xdb\x06\x0c\x67\xa8\xea\x16\xc8\x20\x8f\x36\x99\xad\xda\xed\x79\xae\x49\x1b\xe3\xd9\xf6\xe5\x4c\x8d\xf3\x4a\x42\xe9\x86\x99\x4e\xb2\x4e\x13\x1c\x32\x60\x84\x9f\x5f\x9b\x46\x5e\x14\x98\xaf\xb6\x35\x08\xca\x3a\xd9\x4b\xb1\x75\x9a\xc6\xdc\x6e\xf3\xdf\x8c\xff\x71\xa3\x7e\xb2\x49\xeb\x9e\xee\x58\x2b\xb6\xde\xb6\x56\x30\xe2\xb1\x6b\x71\xc6\xad\xfc\x95\xca\x5f\x31\x8d\xb5\xfb\xcd\xb6\x29\x72\x51\x7e\x67\xeb\xdb\x0f\xa8\x07\x62\xe3\x50\xad\x83\x39\xf2\x53\xdd\x3f\x3d\xd0\xde\xe9\xed\xcd\x9b\x26\x4b\x3c\xe8\x7e\xe9\xd2\x25\x9f\x95\x75\xfa\xc1\xf6\x48\x17\x56\xd2\xb4\xc0\x38\xe4\xbe\xe8\xa2\xa1\xda\xb2\xfe\x60\x7b\xa1\xb7\x17\x22\x5a\xb7\x10\x0c\xbf\xff\x79\x7b\xc3\x61\x38\x64\xbf\xca\x1b\x04\xea\xfd\x1a\xb4\x2d\xf3\xae\x84\xd2\x78\xd5\xf6\x0f\x0c\xbf\xf7\xb3\x24\xc5\xbd\xb6\xa6\xef\xf5\x2c\x7d\xc7\x5d\xd9\xd5\x30\xd8\x1e\xe6\x92\x18\x6b\x1b\xa7\xf6\x29\xc5\x7d\x36\x66\x16\xa2\x79\xe3\xc4\xa0\xfb\x93\x8b\x89\x10\x0e\xa7\xfc\x61\x55\xe1\x75\xdc\xb1\xef\x05\xfb\x56\x9f\x15\xe9\xf8\xf6\x3b\xfe\xef\xee\xff\x0a\xd1\xa8\x87\x15\x8b\x00\x00")
 
 func _2_0_schema_json_bytes() ([]byte, error) {
 	return bindata_read(
@@ -76,7 +76,7 @@ func _2_0_schema_json() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindata_file_info{name: "2.0/schema.json", size: 35628, mode: os.FileMode(420), modTime: time.Unix(1421575197, 0)}
+	info := bindata_file_info{name: "2.0/schema.json", size: 35605, mode: os.FileMode(420), modTime: time.Unix(1434271241, 0)}
 	a := &asset{bytes: bytes, info:  info}
 	return a, nil
 }
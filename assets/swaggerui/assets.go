@@ -0,0 +1,15 @@
+// Package swaggerui contains the embedded files of the swagger-ui-dist package, so the documentation
+// page of the SwaggerUI middleware works without loading swagger-ui from a CDN
+package swaggerui
+
+import "github.com/elazarl/go-bindata-assetfs"
+
+//go:generate go-bindata -pkg=swaggerui -prefix=../../swagger-ui -ignore=LICENSE ../../swagger-ui/...
+
+// Version the version of the swagger-ui-dist package the files come from
+const Version = "5.18.2"
+
+// AssetFS the embedded swagger-ui files as a file system for a http file server
+func AssetFS() *assetfs.AssetFS {
+	return &assetfs.AssetFS{Asset: Asset, AssetDir: AssetDir, AssetInfo: AssetInfo}
+}
@@ -0,0 +1,30 @@
+package commands
+
+import (
+	"errors"
+
+	"github.com/casualjim/go-swagger/cmd/swagger/commands/generate"
+	"github.com/casualjim/go-swagger/convert"
+	"github.com/jessevdk/go-flags"
+)
+
+// ConvertSpec is a command that converts a swagger 1.2 resource listing and its api declarations
+// into a swagger 2.0 document
+type ConvertSpec struct {
+	Output  flags.Filename `long:"output" short:"o" description:"the file to write to"`
+	Format  string         `long:"format" description:"the format for the spec document" choice:"json" choice:"yaml" default:"json"`
+	Compact bool           `long:"compact" description:"write the json spec document without indentation"`
+}
+
+// Execute converts the spec
+func (c *ConvertSpec) Execute(args []string) error {
+	if len(args) == 0 {
+		return errors.New("The convert command requires the url of the swagger 1.2 resource listing to be specified")
+	}
+
+	swspec, err := convert.Swagger12(args[0])
+	if err != nil {
+		return err
+	}
+	return generate.WriteSpec(swspec, string(c.Output), c.Format, c.Compact)
+}
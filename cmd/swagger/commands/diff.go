@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/casualjim/go-swagger/diff"
+	"github.com/casualjim/go-swagger/spec"
+)
+
+// DiffSpec is a command that compares two versions of a swagger document
+// and fails when the new version has breaking changes
+type DiffSpec struct {
+	Format       string `long:"format" description:"the format for the changes" choice:"text" choice:"json" default:"text"`
+	OnlyBreaking bool   `long:"only-breaking" description:"only report the breaking changes"`
+}
+
+// Execute compares the specs
+func (c *DiffSpec) Execute(args []string) error {
+	if len(args) != 2 {
+		return errors.New("The diff command requires the urls of the old and the new swagger document to be specified")
+	}
+
+	old, err := spec.Load(args[0])
+	if err != nil {
+		return err
+	}
+	current, err := spec.Load(args[1])
+	if err != nil {
+		return err
+	}
+
+	var changes diff.Changes
+	for _, change := range diff.Compare(old, current) {
+		if change.Breaking || !c.OnlyBreaking {
+			changes = append(changes, change)
+		}
+	}
+
+	if c.Format == "json" {
+		if changes == nil {
+			changes = diff.Changes{}
+		}
+		b, err := json.MarshalIndent(changes, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+	} else {
+		for _, change := range changes {
+			fmt.Println(change)
+		}
+	}
+
+	if changes.Breaking() {
+		return fmt.Errorf("the swagger spec at %q has breaking changes compared to %q", args[1], args[0])
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package commands
+
+import (
+	"errors"
+
+	"github.com/casualjim/go-swagger/cmd/swagger/commands/generate"
+	"github.com/casualjim/go-swagger/spec"
+	"github.com/jessevdk/go-flags"
+)
+
+// FlattenSpec is a command that bundles a spec that is split over several documents
+// into a single self-contained document
+type FlattenSpec struct {
+	Output  flags.Filename `long:"output" short:"o" description:"the file to write to"`
+	Format  string         `long:"format" description:"the format for the spec document" choice:"json" choice:"yaml" default:"json"`
+	Compact bool           `long:"compact" description:"write the json spec document without indentation"`
+}
+
+// Execute flattens the spec
+func (c *FlattenSpec) Execute(args []string) error {
+	if len(args) == 0 {
+		return errors.New("The flatten command requires the swagger document url to be specified")
+	}
+
+	specDoc, err := spec.Flatten(args[0])
+	if err != nil {
+		return err
+	}
+	return generate.WriteSpec(specDoc.Spec(), string(c.Output), c.Format, c.Compact)
+}
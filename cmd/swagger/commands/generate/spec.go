@@ -2,12 +2,14 @@ package generate
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
+	"io/ioutil"
 	"os"
 
 	"github.com/casualjim/go-swagger/scan"
 	"github.com/casualjim/go-swagger/spec"
+	"github.com/casualjim/go-swagger/swag"
 	"github.com/jessevdk/go-flags"
 )
 
@@ -16,21 +18,40 @@ type SpecFile struct {
 	BasePath string         `long:"base-path" short:"b" description:"the base path to use" default:"."`
 	Output   flags.Filename `long:"output" short:"o" description:"the file to write to"`
 	Input    flags.Filename `long:"input" short:"i" description:"the file to use as input"`
+	Infer    bool           `long:"infer-signatures" description:"derive the parameters and responses of a route from the signature of the function it documents"`
+	Include  []string       `long:"include" description:"include the packages matching this pattern in the discovery, repeat for multiple"`
+	Exclude  []string       `long:"exclude" description:"exclude the packages matching this pattern from the discovery, repeat for multiple"`
+	Merge    string         `long:"merge" description:"how to merge the scanned code into the input spec" choice:"scanned-wins" choice:"input-wins" choice:"error-on-conflict" default:"scanned-wins"`
+	Format   string         `long:"format" description:"the format for the spec document" choice:"json" choice:"yaml" default:"json"`
+	Compact  bool           `long:"compact" description:"write the json spec document without indentation"`
 }
 
 // Execute runs this command
 func (s *SpecFile) Execute(args []string) error {
+	// fail before scanning the code
+	if err := checkOutput(s.Format, s.Compact); err != nil {
+		return err
+	}
+
 	input, err := loadSpec(string(s.Input))
 	if err != nil {
 		return err
 	}
 
-	swspec, err := scan.Application(s.BasePath, input, nil, nil)
+	swspec, err := scan.Run(&scan.Opts{
+		BasePath:        s.BasePath,
+		Input:           input,
+		Merge:           scan.MergeStrategy(s.Merge),
+		Include:         s.Include,
+		Exclude:         s.Exclude,
+		InferSignatures: s.Infer,
+		Logger:          swag.DefaultLogger(),
+	})
 	if err != nil {
 		return err
 	}
 
-	return writeToFile(swspec, string(s.Output))
+	return WriteSpec(swspec, string(s.Output), s.Format, s.Compact)
 }
 
 var (
@@ -51,18 +72,43 @@ func loadSpec(input string) (*spec.Swagger, error) {
 	return nil, nil
 }
 
-func writeToFile(swspec *spec.Swagger, output string) error {
-	var wrtr io.WriteCloser = os.Stdout
-	if output != "" {
-		wrtr = os.Stdout
-		defer wrtr.Close()
+// WriteSpec writes the spec as json or yaml to the output file, or to stdout when no output file is provided.
+// The keys of the json objects are written in a stable order, so the same spec always produces the same document.
+// That order is alphabetical, the properties of a schema don't keep the order of the fields in the source code
+// because the spec stores them in a map. The compact option only applies to json.
+func WriteSpec(swspec *spec.Swagger, output, format string, compact bool) error {
+	if err := checkOutput(format, compact); err != nil {
+		return err
 	}
 
-	b, err := json.Marshal(swspec)
+	var b []byte
+	var err error
+	if compact {
+		b, err = json.Marshal(swspec)
+	} else {
+		b, err = json.MarshalIndent(swspec, "", "  ")
+	}
 	if err != nil {
 		return err
 	}
-	wrtr.Write(b)
-	wrtr.Write(newLine)
+	if format == "yaml" {
+		if b, err = swag.JSONToYAML(b); err != nil {
+			return err
+		}
+	} else {
+		b = append(b, newLine...)
+	}
+
+	if output == "" {
+		_, err = os.Stdout.Write(b)
+		return err
+	}
+	return ioutil.WriteFile(output, b, 0644)
+}
+
+func checkOutput(format string, compact bool) error {
+	if compact && format == "yaml" {
+		return errors.New("the compact option can only be used for the json format")
+	}
 	return nil
 }
@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/casualjim/go-swagger/cmd/swagger/commands/generate"
+	"github.com/casualjim/go-swagger/spec"
+	"github.com/jessevdk/go-flags"
+)
+
+// MixinSpec is a command that merges several swagger documents into the first one
+type MixinSpec struct {
+	Output         flags.Filename `long:"output" short:"o" description:"the file to write to"`
+	Format         string         `long:"format" description:"the format for the spec document" choice:"json" choice:"yaml" default:"json"`
+	Compact        bool           `long:"compact" description:"write the json spec document without indentation"`
+	IgnoreConflict bool           `long:"ignore-conflicts" description:"write the merged spec even when there are collisions"`
+}
+
+// Execute merges the specs
+func (c *MixinSpec) Execute(args []string) error {
+	if len(args) < 2 {
+		return errors.New("The mixin command requires the primary swagger document and at least one swagger document to mix in")
+	}
+
+	var docs []*spec.Swagger
+	for _, arg := range args {
+		doc, err := spec.Load(arg)
+		if err != nil {
+			return err
+		}
+		docs = append(docs, doc.Spec())
+	}
+
+	collisions := spec.Mixin(docs[0], docs[1:]...)
+	for _, collision := range collisions {
+		fmt.Fprintln(os.Stderr, collision)
+	}
+	if len(collisions) > 0 && !c.IgnoreConflict {
+		return fmt.Errorf("the specs have %d collisions, use --ignore-conflicts to write the merged spec anyway", len(collisions))
+	}
+	return generate.WriteSpec(docs[0], string(c.Output), c.Format, c.Compact)
+}
@@ -0,0 +1,231 @@
+package commands
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/casualjim/go-swagger/assets/swaggerui"
+	swaggererrors "github.com/casualjim/go-swagger/errors"
+	"github.com/casualjim/go-swagger/httpkit/middleware"
+	"github.com/casualjim/go-swagger/httpkit/mock"
+	"github.com/casualjim/go-swagger/spec"
+	"github.com/casualjim/go-swagger/strfmt"
+	"github.com/casualjim/go-swagger/validate"
+)
+
+// ServeSpec is a command that serves a swagger document with a documentation page,
+// so the document can be previewed without generating a server first.
+// With the mock option the operations of the document are served too, they respond
+// with the examples of the document, or with random data with the random option.
+type ServeSpec struct {
+	Mock         bool          `long:"mock" description:"serve the operations of the spec with mocked responses"`
+	Random       bool          `long:"random" description:"mock the responses without examples with random data"`
+	Seed         int64         `long:"seed" description:"the seed for the random data of the mocked responses, the current time when it's 0" default:"0"`
+	Host         string        `long:"host" description:"the interface to listen on" default:"localhost"`
+	Port         int           `long:"port" short:"p" description:"the port to listen on, a free port is picked when it's 0" default:"0"`
+	BasePath     string        `long:"base-path" description:"the base path to serve the spec and the documentation at" default:"/"`
+	DocPath      string        `long:"doc-path" description:"the path of the documentation page relative to the base path" default:"docs"`
+	NoUI         bool          `long:"no-ui" description:"only serve the spec document"`
+	AssetsURL    string        `long:"assets-url" description:"the url to load swagger-ui from instead of the embedded files, like https://unpkg.com/swagger-ui-dist@5.18.2"`
+	NoReload     bool          `long:"no-reload" description:"don't reload the spec document when the files change"`
+	PollInterval time.Duration `long:"poll-interval" description:"how often to check the files for changes" default:"1s"`
+}
+
+// Execute serves the spec
+func (c *ServeSpec) Execute(args []string) error {
+	if len(args) == 0 {
+		return errors.New("The serve command requires the swagger document url to be specified")
+	}
+
+	sh := &servedSpec{location: args[0], mock: c.Mock || c.Random}
+	if c.Random {
+		sh.seed = c.Seed
+		if sh.seed == 0 {
+			sh.seed = time.Now().UnixNano()
+		}
+		log.Printf("mocking the responses with random data for the seed %d", sh.seed)
+	}
+	if err := sh.load(); err != nil {
+		return err
+	}
+
+	basePath := "/" + strings.Trim(c.BasePath, "/")
+	specURL := strings.TrimSuffix(basePath, "/") + "/swagger.json"
+	handler := sh.handler(specURL, http.NotFoundHandler())
+	if !c.NoUI {
+		opts := middleware.SwaggerUIOpts{
+			BasePath:  basePath,
+			Path:      c.DocPath,
+			SpecURL:   specURL,
+			Assets:    swaggerui.AssetFS,
+			AssetsURL: c.AssetsURL,
+			Title:     sh.title(),
+		}
+		if !c.NoReload {
+			opts.ReloadInterval = int(c.PollInterval / time.Millisecond)
+		}
+		handler = middleware.SwaggerUI(opts, handler)
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort(c.Host, strconv.Itoa(c.Port)))
+	if err != nil {
+		return err
+	}
+	addr := "http://" + listener.Addr().String()
+	if c.NoUI {
+		log.Printf("serving the spec at %s%s", addr, specURL)
+	} else {
+		log.Printf("serving the docs at %s%s", addr, strings.TrimSuffix(basePath, "/")+"/"+strings.Trim(c.DocPath, "/"))
+	}
+
+	if !c.NoReload && !strings.HasPrefix(args[0], "http://") && !strings.HasPrefix(args[0], "https://") {
+		go sh.watch(c.PollInterval)
+	}
+	return http.Serve(listener, handler)
+}
+
+// servedSpec holds the last valid version of the spec document that gets served
+type servedSpec struct {
+	location string
+	mock     bool
+	// seed when set the mocked responses get random data
+	seed int64
+
+	lock sync.RWMutex
+	doc  *spec.Document
+	data []byte
+	etag string
+	api  http.Handler
+}
+
+// load validates and flattens the spec document, the served document only gets replaced when it's valid
+func (s *servedSpec) load() error {
+	doc, err := spec.Flatten(s.location)
+	if err != nil {
+		return err
+	}
+	if result := validate.Spec(doc, strfmt.Default); result != nil {
+		str := fmt.Sprintf("The swagger spec at %q is invalid against swagger specification %s. see errors :\n", s.location, doc.Version())
+		if ce, ok := result.(*swaggererrors.CompositeError); ok {
+			for _, desc := range ce.Errors {
+				str += fmt.Sprintf("- %s\n", desc)
+			}
+		} else {
+			str += fmt.Sprintf("- %s\n", result)
+		}
+		return errors.New(str)
+	}
+
+	var api http.Handler
+	if s.mock {
+		if s.seed != 0 {
+			api, err = mock.ServeRandom(doc, s.seed)
+		} else {
+			api, err = mock.Serve(doc)
+		}
+		if err != nil {
+			return err
+		}
+		// the documentation page sends the requests to the mocked operations on this server
+		doc.Spec().Host = ""
+		doc.Spec().Schemes = nil
+	}
+
+	data, err := json.MarshalIndent(doc.Spec(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	s.doc = doc
+	s.data = data
+	s.api = api
+	s.etag = fmt.Sprintf("%q", fmt.Sprintf("%x", sha1.Sum(data)))
+	s.lock.Unlock()
+	return nil
+}
+
+func (s *servedSpec) title() string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if info := s.doc.Spec().Info; info != nil && info.Title != "" {
+		return info.Title
+	}
+	return ""
+}
+
+func (s *servedSpec) handler(specURL string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		s.lock.RLock()
+		data, etag, api := s.data, s.etag, s.api
+		s.lock.RUnlock()
+
+		if r.URL.Path != specURL {
+			if api != nil {
+				api.ServeHTTP(rw, r)
+				return
+			}
+			next.ServeHTTP(rw, r)
+			return
+		}
+
+		rw.Header().Set("ETag", etag)
+		rw.Header().Set("Cache-Control", "no-cache")
+		if r.Header.Get("If-None-Match") == etag {
+			rw.WriteHeader(http.StatusNotModified)
+			return
+		}
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(http.StatusOK)
+		if r.Method != "HEAD" {
+			rw.Write(data)
+		}
+	})
+}
+
+// watch polls the directory of the spec document and reloads the spec when one of the
+// json or yaml files in it changed, this also picks up changes to the documents it refers to
+func (s *servedSpec) watch(interval time.Duration) {
+	dir := filepath.Dir(s.location)
+	last := latestModTime(dir)
+	for range time.Tick(interval) {
+		current := latestModTime(dir)
+		if !current.After(last) {
+			continue
+		}
+		last = current
+		if err := s.load(); err != nil {
+			log.Printf("keeping the previous version of the spec: %v", err)
+			continue
+		}
+		log.Printf("reloaded the spec at %q", s.location)
+	}
+}
+
+func latestModTime(dir string) time.Time {
+	var latest time.Time
+	filepath.Walk(dir, func(pth string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return nil
+		}
+		switch strings.ToLower(filepath.Ext(pth)) {
+		case ".json", ".yaml", ".yml":
+			if info.ModTime().After(latest) {
+				latest = info.ModTime()
+			}
+		}
+		return nil
+	})
+	return latest
+}
@@ -0,0 +1,133 @@
+package commands
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func servedPetstore(t testing.TB, mock bool) (*servedSpec, string) {
+	b, err := ioutil.ReadFile("../../../fixtures/mock/petstore.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "serve-spec")
+	if err != nil {
+		t.Fatal(err)
+	}
+	location := filepath.Join(dir, "swagger.yaml")
+	if err := ioutil.WriteFile(location, b, 0644); err != nil {
+		t.Fatal(err)
+	}
+	sh := &servedSpec{location: location, mock: mock}
+	if err := sh.load(); err != nil {
+		t.Fatal(err)
+	}
+	return sh, location
+}
+
+func serveRequest(handler http.Handler, method, pth, etag string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(method, pth, nil)
+	req.Header.Set("Accept", "application/json")
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestServedSpec_ETag(t *testing.T) {
+	sh, location := servedPetstore(t, false)
+	defer os.RemoveAll(filepath.Dir(location))
+	handler := sh.handler("/swagger.json", http.NotFoundHandler())
+
+	rsp := serveRequest(handler, "GET", "/swagger.json", "")
+	assert.Equal(t, http.StatusOK, rsp.Code)
+	assert.Equal(t, "application/json", rsp.Header().Get("Content-Type"))
+	etag := rsp.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+	var doc map[string]interface{}
+	if assert.NoError(t, json.Unmarshal(rsp.Body.Bytes(), &doc)) {
+		assert.Equal(t, "Petstore", doc["info"].(map[string]interface{})["title"])
+	}
+
+	rsp = serveRequest(handler, "GET", "/swagger.json", etag)
+	assert.Equal(t, http.StatusNotModified, rsp.Code)
+	assert.Equal(t, etag, rsp.Header().Get("ETag"))
+	assert.Empty(t, rsp.Body.String())
+
+	rsp = serveRequest(handler, "HEAD", "/swagger.json", "")
+	assert.Equal(t, http.StatusOK, rsp.Code)
+	assert.Equal(t, etag, rsp.Header().Get("ETag"))
+	assert.Empty(t, rsp.Body.String())
+
+	rsp = serveRequest(handler, "GET", "/swagger.json", `"outdated"`)
+	assert.Equal(t, http.StatusOK, rsp.Code)
+	assert.NotEmpty(t, rsp.Body.String())
+}
+
+func TestServedSpec_Reload(t *testing.T) {
+	sh, location := servedPetstore(t, false)
+	defer os.RemoveAll(filepath.Dir(location))
+	handler := sh.handler("/swagger.json", http.NotFoundHandler())
+	etag := serveRequest(handler, "GET", "/swagger.json", "").Header().Get("ETag")
+
+	b, err := ioutil.ReadFile(location)
+	if !assert.NoError(t, err) {
+		return
+	}
+	changed := strings.Replace(string(b), "title: Petstore", "title: Pet shop", 1)
+	if !assert.NoError(t, ioutil.WriteFile(location, []byte(changed), 0644)) || !assert.NoError(t, sh.load()) {
+		return
+	}
+	rsp := serveRequest(handler, "GET", "/swagger.json", etag)
+	assert.Equal(t, http.StatusOK, rsp.Code)
+	assert.Contains(t, rsp.Body.String(), "Pet shop")
+	reloaded := rsp.Header().Get("ETag")
+	assert.NotEqual(t, etag, reloaded)
+	assert.Equal(t, "Pet shop", sh.title())
+
+	// an invalid version keeps the last valid one
+	invalid := strings.Replace(changed, "version: 1.0.0", "", 1)
+	if !assert.NoError(t, ioutil.WriteFile(location, []byte(invalid), 0644)) {
+		return
+	}
+	assert.Error(t, sh.load())
+	rsp = serveRequest(handler, "GET", "/swagger.json", reloaded)
+	assert.Equal(t, http.StatusNotModified, rsp.Code)
+	rsp = serveRequest(handler, "GET", "/swagger.json", "")
+	assert.Contains(t, rsp.Body.String(), "1.0.0")
+}
+
+func TestServedSpec_Mock(t *testing.T) {
+	sh, location := servedPetstore(t, true)
+	defer os.RemoveAll(filepath.Dir(location))
+	handler := sh.handler("/swagger.json", http.NotFoundHandler())
+
+	rsp := serveRequest(handler, "GET", "/api/pets", "")
+	assert.Equal(t, http.StatusOK, rsp.Code)
+	assert.Contains(t, rsp.Body.String(), "Rex")
+
+	// the documentation page sends the requests to this server
+	rsp = serveRequest(handler, "GET", "/swagger.json", "")
+	var doc map[string]interface{}
+	if assert.NoError(t, json.Unmarshal(rsp.Body.Bytes(), &doc)) {
+		assert.NotContains(t, doc, "host")
+		assert.NotContains(t, doc, "schemes")
+	}
+
+	// without the mock the operations aren't served
+	sh, location = servedPetstore(t, false)
+	defer os.RemoveAll(filepath.Dir(location))
+	handler = sh.handler("/swagger.json", http.NotFoundHandler())
+	rsp = serveRequest(handler, "GET", "/api/pets", "")
+	assert.Equal(t, http.StatusNotFound, rsp.Code)
+}
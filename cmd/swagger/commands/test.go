@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/casualjim/go-swagger/contract"
+	"github.com/casualjim/go-swagger/spec"
+)
+
+// TestSpec is a command that tests a running server against a swagger document,
+// it sends valid and invalid requests for every operation and checks the responses
+type TestSpec struct {
+	BaseURL   string   `long:"base-url" short:"u" description:"the url of the server, the base path of the spec is added to it" required:"true"`
+	Headers   []string `long:"header" short:"H" description:"a header for every request, like the credentials for the secured operations, in the name: value format"`
+	Seed      int64    `long:"seed" description:"the seed for the random values of the parameters" default:"1"`
+	NoInvalid bool     `long:"no-invalid" description:"only send the valid requests"`
+	Format    string   `long:"format" description:"the format for the results" choice:"text" choice:"json" default:"text"`
+}
+
+// Execute tests the server
+func (c *TestSpec) Execute(args []string) error {
+	if len(args) == 0 {
+		return errors.New("The test command requires the swagger document url to be specified")
+	}
+
+	doc, err := spec.Load(args[0])
+	if err != nil {
+		return err
+	}
+
+	tester := contract.New(doc, c.BaseURL)
+	tester.Seed = c.Seed
+	tester.NoInvalid = c.NoInvalid
+	for _, header := range c.Headers {
+		parts := strings.SplitN(header, ":", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("the header %q isn't in the name: value format", header)
+		}
+		tester.Header.Add(http.CanonicalHeaderKey(strings.TrimSpace(parts[0])), strings.TrimSpace(parts[1]))
+	}
+
+	results, err := tester.Run()
+	if err != nil {
+		return err
+	}
+
+	if c.Format == "json" {
+		if results == nil {
+			results = contract.Results{}
+		}
+		b, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+	} else {
+		for _, result := range results {
+			fmt.Println(result)
+		}
+	}
+
+	if failed := results.Failed(); len(failed) > 0 {
+		return fmt.Errorf("%d of the %d requests for the swagger spec at %q failed", len(failed), len(results), args[0])
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"log"
+	"os"
 
 	"github.com/casualjim/go-swagger/cmd/swagger/commands"
 	"github.com/jessevdk/go-flags"
@@ -17,6 +18,12 @@ Swagger tries to support you as best as possible when building API's
 It aims to represent the contract of your API with a language agnostic description of your application in json or yaml.
 `
 	parser.AddCommand("validate", "validate the swagger document", "validate the provided swagger document against a swagger spec", &commands.ValidateSpec{})
+	parser.AddCommand("convert", "convert a swagger 1.2 document", "convert the provided swagger 1.2 resource listing and its api declarations into a swagger 2.0 document", &commands.ConvertSpec{})
+	parser.AddCommand("diff", "compare swagger documents", "compare two versions of a swagger document and report the breaking and compatible changes", &commands.DiffSpec{})
+	parser.AddCommand("mixin", "merge swagger documents", "merge the paths, definitions, parameters, responses, security definitions and tags of the other swagger documents into the first one", &commands.MixinSpec{})
+	parser.AddCommand("serve", "serve the swagger document", "validate, flatten and serve the provided swagger document with a documentation page and optionally mocked operations, the document is reloaded when the files change", &commands.ServeSpec{})
+	parser.AddCommand("flatten", "flatten the swagger document", "bundle the provided swagger document and the documents it refers to into a single swagger document", &commands.FlattenSpec{})
+	parser.AddCommand("test", "test a server against the swagger document", "send valid and invalid requests for every operation of the provided swagger document to a running server and check that the responses match the document", &commands.TestSpec{})
 
 	genpar, err := parser.AddCommand("generate", "genererate go code", "generate go code for the swagger spec file", &commands.Generate{})
 	if err != nil {
@@ -42,5 +49,10 @@ It aims to represent the contract of your API with a language agnostic descripti
 		}
 	}
 
-	parser.Parse()
+	if _, err := parser.Parse(); err != nil {
+		if fe, ok := err.(*flags.Error); ok && fe.Type == flags.ErrHelp {
+			return
+		}
+		os.Exit(1)
+	}
 }
@@ -0,0 +1,81 @@
+package contract
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/casualjim/go-swagger/internal/validate"
+	"github.com/casualjim/go-swagger/spec"
+	"github.com/casualjim/go-swagger/strfmt"
+)
+
+// check checks the response of the server for the case, a valid request needs a declared response
+// and an invalid request needs a 4xx response, the body of a declared response with a schema needs
+// to be json that matches the schema
+func (t *Tester) check(c Case, rsp *http.Response, body []byte) []string {
+	response, declared := responseFor(t.doc.Spec(), c.operation, rsp.StatusCode)
+	if c.Valid && !declared {
+		return []string{fmt.Sprintf("the status %d isn't declared for the operation", rsp.StatusCode)}
+	}
+	if !c.Valid && (rsp.StatusCode < 400 || rsp.StatusCode >= 500) {
+		return []string{"the invalid request wasn't rejected with a 4xx status"}
+	}
+	if !declared || response.Schema == nil || c.Method == "HEAD" {
+		return nil
+	}
+
+	if len(bytes.TrimSpace(body)) == 0 {
+		// a no content response can't have a body, even when the spec declares a schema for it
+		if rsp.StatusCode == http.StatusNoContent {
+			return nil
+		}
+		return []string{"the response has no body"}
+	}
+	// only the json bodies can be checked against the schema, the others fail instead of passing unchecked
+	contentType := rsp.Header.Get("Content-Type")
+	if contentType == "" {
+		return []string{"the response has a body without a content type, it can't be checked against the schema"}
+	}
+	if !strings.Contains(strings.ToLower(contentType), "json") {
+		return []string{fmt.Sprintf("the response has a %s body, only json bodies can be checked against the schema", contentType)}
+	}
+	var data interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return []string{fmt.Sprintf("the body isn't valid json: %v", err)}
+	}
+
+	formats := t.Formats
+	if formats == nil {
+		formats = strfmt.Default
+	}
+	schema := *response.Schema
+	var errs []string
+	for _, err := range validate.NewSchemaValidator(&schema, t.doc.Spec(), "body", formats).Validate(data).Errors {
+		errs = append(errs, err.Error())
+	}
+	return errs
+}
+
+// responseFor the response of the operation for the status code, or the default response
+// when the status code isn't declared
+func responseFor(sp *spec.Swagger, op *spec.Operation, status int) (*spec.Response, bool) {
+	if op.Responses == nil {
+		return nil, false
+	}
+	response, ok := op.Responses.StatusCodeResponses[status]
+	if !ok {
+		if op.Responses.Default == nil {
+			return nil, false
+		}
+		response = *op.Responses.Default
+	}
+	if ref := response.Ref.String(); strings.HasPrefix(ref, "#/responses/") {
+		if resolved, ok := sp.Responses[strings.TrimPrefix(ref, "#/responses/")]; ok {
+			response = resolved
+		}
+	}
+	return &response, true
+}
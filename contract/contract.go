@@ -0,0 +1,240 @@
+// Package contract tests a running server against its swagger spec.
+//
+// Every operation of the spec gets a request with random but valid values for its parameters.
+// The server has to respond with one of the declared responses of the operation, and when that
+// response has a schema the json body of the response has to match it.
+//
+// Every operation also gets invalid requests, which are the valid request with a single mistake:
+//
+//   - a required parameter or the required body is left out
+//   - a number or boolean parameter gets a value of another type
+//   - a parameter gets a value above its maximum, below its minimum, outside its enum
+//     or longer or shorter than its length limits
+//   - the body misses a required property or has the wrong type
+//
+// The server has to reject these with a 4xx status, when that status is declared the body has to
+// match the schema of the response too.
+package contract
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/casualjim/go-swagger/fake"
+	"github.com/casualjim/go-swagger/spec"
+	"github.com/casualjim/go-swagger/strfmt"
+)
+
+// Case is a request for an operation of the spec
+type Case struct {
+	OperationID string `json:"operationId"`
+	Method      string `json:"method"`
+	Path        string `json:"path"`
+	// Description tells what the request tests
+	Description string `json:"description"`
+	// Valid is true when the request is valid for the spec, so the server should accept it
+	Valid bool `json:"valid"`
+
+	request   *request
+	operation *spec.Operation
+}
+
+// Result is the outcome of a case
+type Result struct {
+	Case
+	// Status is the status code of the response, it's 0 when the request couldn't be sent
+	Status int `json:"status"`
+	// Errors tells why the case failed
+	Errors []string `json:"errors,omitempty"`
+}
+
+// Passed returns true when the server responded as the spec says
+func (r Result) Passed() bool {
+	return len(r.Errors) == 0
+}
+
+func (r Result) String() string {
+	outcome := "pass"
+	if !r.Passed() {
+		outcome = "fail"
+	}
+	str := fmt.Sprintf("%s %s %s (%s) %s: %d", outcome, r.Method, r.Path, r.OperationID, r.Description, r.Status)
+	for _, err := range r.Errors {
+		str += "\n  - " + err
+	}
+	return str
+}
+
+// Results are the outcomes of the cases
+type Results []Result
+
+// Failed returns the results of the cases that failed
+func (r Results) Failed() Results {
+	var failed Results
+	for _, result := range r {
+		if !result.Passed() {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// Tester runs the cases for the operations of a spec against a server
+type Tester struct {
+	// BaseURL is the url of the server, the base path of the spec is added to it
+	BaseURL string
+	// Client sends the requests, defaults to http.DefaultClient
+	Client *http.Client
+	// Header is added to every request, like the credentials for the secured operations
+	Header http.Header
+	// Formats validates the formats in the response bodies, defaults to strfmt.Default
+	Formats strfmt.Registry
+	// Seed seeds the random values of the parameters, the same seed sends the same requests
+	Seed int64
+	// NoInvalid only sends the valid requests
+	NoInvalid bool
+
+	doc *spec.Document
+}
+
+// New creates a tester for the operations of the spec document, served at the base url
+func New(doc *spec.Document, baseURL string) *Tester {
+	return &Tester{
+		BaseURL: baseURL,
+		Client:  http.DefaultClient,
+		Header:  make(http.Header),
+		Formats: strfmt.Default,
+		Seed:    1,
+		doc:     doc,
+	}
+}
+
+// Cases builds the requests for the operations, sorted by path and method
+func (t *Tester) Cases() ([]Case, error) {
+	gen := fake.New(t.doc.Spec(), t.Formats, t.Seed)
+
+	var cases []Case
+	for _, op := range t.operations() {
+		params, err := t.parameters(op.path, op.operation)
+		if err != nil {
+			return nil, err
+		}
+		valid, err := validRequest(gen, params)
+		if err != nil {
+			return nil, fmt.Errorf("can't build a request for %s %s: %v", op.method, op.path, err)
+		}
+
+		c := Case{
+			OperationID: op.operation.ID,
+			Method:      op.method,
+			Path:        op.path,
+			operation:   op.operation,
+		}
+		vc := c
+		vc.Description, vc.Valid, vc.request = "a valid request", true, valid
+		cases = append(cases, vc)
+		if t.NoInvalid {
+			continue
+		}
+		for _, inv := range invalidRequests(t.doc.Spec(), params, valid) {
+			ic := c
+			ic.Description, ic.request = inv.description, inv.request
+			cases = append(cases, ic)
+		}
+	}
+	return cases, nil
+}
+
+// Run sends the requests to the server and checks the responses
+func (t *Tester) Run() (Results, error) {
+	cases, err := t.Cases()
+	if err != nil {
+		return nil, err
+	}
+	results := make(Results, 0, len(cases))
+	for _, c := range cases {
+		results = append(results, t.run(c))
+	}
+	return results, nil
+}
+
+func (t *Tester) run(c Case) Result {
+	result := Result{Case: c}
+	rsp, body, err := t.send(c)
+	if err != nil {
+		result.Errors = []string{err.Error()}
+		return result
+	}
+	result.Status = rsp.StatusCode
+	result.Errors = t.check(c, rsp, body)
+	return result
+}
+
+type pathOperation struct {
+	method    string
+	path      string
+	operation *spec.Operation
+}
+
+func (t *Tester) operations() []pathOperation {
+	var ops []pathOperation
+	for method, paths := range t.doc.Operations() {
+		for path, op := range paths {
+			ops = append(ops, pathOperation{method: strings.ToUpper(method), path: path, operation: op})
+		}
+	}
+	sort.Sort(byPathAndMethod(ops))
+	return ops
+}
+
+type byPathAndMethod []pathOperation
+
+func (b byPathAndMethod) Len() int      { return len(b) }
+func (b byPathAndMethod) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
+func (b byPathAndMethod) Less(i, j int) bool {
+	if b[i].path == b[j].path {
+		return b[i].method < b[j].method
+	}
+	return b[i].path < b[j].path
+}
+
+// parameters the parameters of the path and the operation, the refs to the parameters of the spec
+// are resolved and the operation overrides the parameters of the path
+func (t *Tester) parameters(path string, op *spec.Operation) ([]spec.Parameter, error) {
+	byKey := make(map[string]spec.Parameter)
+	var keys []string
+	add := func(params []spec.Parameter) error {
+		for _, param := range params {
+			if ref := param.Ref.String(); ref != "" {
+				resolved, ok := t.doc.Spec().Parameters[strings.TrimPrefix(ref, "#/parameters/")]
+				if !strings.HasPrefix(ref, "#/parameters/") || !ok {
+					return fmt.Errorf("can't resolve the parameter %q", ref)
+				}
+				param = resolved
+			}
+			key := param.In + "#" + param.Name
+			if _, ok := byKey[key]; !ok {
+				keys = append(keys, key)
+			}
+			byKey[key] = param
+		}
+		return nil
+	}
+	if pi, ok := t.doc.Spec().Paths.Paths[path]; ok {
+		if err := add(pi.Parameters); err != nil {
+			return nil, err
+		}
+	}
+	if err := add(op.Parameters); err != nil {
+		return nil, err
+	}
+
+	sort.Strings(keys)
+	params := make([]spec.Parameter, 0, len(keys))
+	for _, key := range keys {
+		params = append(params, byKey[key])
+	}
+	return params, nil
+}
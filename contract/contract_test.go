@@ -0,0 +1,179 @@
+package contract
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/casualjim/go-swagger/httpkit/mock"
+	"github.com/casualjim/go-swagger/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+func loadPetstore(t testing.TB) *spec.Document {
+	doc, err := spec.Load("../fixtures/contract/petstore.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+func TestCases(t *testing.T) {
+	tester := New(loadPetstore(t), "http://localhost")
+	cases, err := tester.Cases()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var descriptions []string
+	for _, c := range cases {
+		descriptions = append(descriptions, c.Method+" "+c.Path+" "+c.Description)
+	}
+	assert.Equal(t, []string{
+		"GET /pets a valid request",
+		"GET /pets with the query parameter limit that isn't a number",
+		"GET /pets with the query parameter limit above the maximum",
+		"GET /pets with the query parameter limit below the minimum",
+		"GET /pets without the query parameter status",
+		"GET /pets with the query parameter status outside the enum",
+		"POST /pets a valid request",
+		"POST /pets without the body",
+		"POST /pets with a body without the required property id",
+		"POST /pets with a body that isn't an object",
+		"GET /pets/{id} a valid request",
+		"GET /pets/{id} with the header parameter X-Request-ID longer than the max length",
+		"GET /pets/{id} with the header parameter X-Request-ID shorter than the min length",
+		"GET /pets/{id} with the path parameter id that isn't a number",
+		"GET /pets/{id} with the path parameter id below the minimum",
+	}, descriptions)
+
+	valid := cases[0].request
+	assert.Contains(t, []string{"available", "sold"}, valid.query.Get("status"))
+	assert.Regexp(t, `^([a-z]{3,8}(\|[a-z]{3,8})*)?$`, valid.query.Get("tags"))
+
+	same, _ := New(loadPetstore(t), "http://localhost").Cases()
+	assert.Equal(t, cases[0].request, same[0].request)
+}
+
+func TestFormatParam(t *testing.T) {
+	values := []interface{}{"a", int64(2), 1.5, true}
+	assert.Equal(t, []string{"a,2,1.5,true"}, formatParam(values, "", nil))
+	assert.Equal(t, []string{"a 2 1.5 true"}, formatParam(values, "ssv", nil))
+	assert.Equal(t, []string{"a\t2\t1.5\ttrue"}, formatParam(values, "tsv", nil))
+	assert.Equal(t, []string{"a|2|1.5|true"}, formatParam(values, "pipes", nil))
+	assert.Equal(t, []string{"a", "2", "1.5", "true"}, formatParam(values, "multi", nil))
+
+	items := new(spec.Items).CollectionOf(new(spec.Items).Typed("integer", ""), "csv")
+	nested := []interface{}{[]interface{}{int64(1), int64(2)}, []interface{}{int64(3)}}
+	assert.Equal(t, []string{"1,2|3"}, formatParam(nested, "pipes", items))
+}
+
+func TestRun_Mock(t *testing.T) {
+	doc := loadPetstore(t)
+	handler, err := mock.Serve(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	tester := New(doc, server.URL)
+	tester.Header.Set("X-API-Key", "secret")
+	results, err := tester.Run()
+	if assert.NoError(t, err) {
+		assert.Len(t, results, 15)
+		for _, result := range results.Failed() {
+			t.Error(result)
+		}
+	}
+}
+
+func TestRun_Broken(t *testing.T) {
+	doc := loadPetstore(t)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/pets":
+			rw.WriteHeader(http.StatusOK)
+			rw.Write([]byte(`[{"id": 1}]`))
+		default:
+			rw.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	tester := New(doc, server.URL)
+	tester.NoInvalid = true
+	results, err := tester.Run()
+	if !assert.NoError(t, err) || !assert.Len(t, results, 3) {
+		return
+	}
+
+	failed := results.Failed()
+	if assert.Len(t, failed, 3) {
+		assert.Equal(t, "listPets", failed[0].OperationID)
+		assert.Equal(t, 200, failed[0].Status)
+		assert.Equal(t, []string{"body.name in body is required"}, failed[0].Errors)
+		// the default response of createPet is an error
+		assert.Equal(t, []string{`body in body must be of type object: "array"`}, failed[1].Errors)
+		assert.Equal(t, []string{"the status 500 isn't declared for the operation"}, failed[2].Errors)
+	}
+
+	tester.NoInvalid = false
+	results, err = tester.Run()
+	if assert.NoError(t, err) {
+		for _, result := range results[1:6] {
+			assert.Equal(t, []string{"the invalid request wasn't rejected with a 4xx status"}, result.Errors)
+		}
+	}
+}
+
+func TestRun_ContentType(t *testing.T) {
+	doc := loadPetstore(t)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/pets":
+			rw.Header().Set("Content-Type", "text/plain")
+		default:
+			// keeps the server from sniffing a content type
+			rw.Header()["Content-Type"] = nil
+		}
+		rw.WriteHeader(http.StatusOK)
+		rw.Write([]byte(`{"id": 1, "name": "Rex"}`))
+	}))
+	defer server.Close()
+
+	tester := New(doc, server.URL)
+	tester.NoInvalid = true
+	results, err := tester.Run()
+	if !assert.NoError(t, err) || !assert.Len(t, results, 3) {
+		return
+	}
+	assert.Equal(t, []string{"the response has a text/plain body, only json bodies can be checked against the schema"}, results[0].Errors)
+	assert.Equal(t, []string{"the response has a body without a content type, it can't be checked against the schema"}, results[2].Errors)
+}
+
+func TestRun_EmptyBody(t *testing.T) {
+	doc := loadPetstore(t)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		if r.Method == "POST" {
+			rw.WriteHeader(http.StatusCreated)
+			return
+		}
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	tester := New(doc, server.URL)
+	tester.NoInvalid = true
+	results, err := tester.Run()
+	if !assert.NoError(t, err) || !assert.Len(t, results, 3) {
+		return
+	}
+	assert.Equal(t, []string{"the status 204 isn't declared for the operation"}, results[0].Errors)
+	// the created pet has a schema, so the body can't be empty
+	assert.Equal(t, "createPet", results[1].OperationID)
+	assert.Equal(t, 201, results[1].Status)
+	assert.Equal(t, []string{"the response has no body"}, results[1].Errors)
+}
@@ -0,0 +1,376 @@
+package contract
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/casualjim/go-swagger/fake"
+	"github.com/casualjim/go-swagger/httpkit"
+	"github.com/casualjim/go-swagger/spec"
+)
+
+// request the values of the parameters for a request
+type request struct {
+	path    map[string]string
+	query   url.Values
+	header  http.Header
+	form    url.Values
+	files   []string
+	body    interface{}
+	hasBody bool
+}
+
+func newRequest() *request {
+	return &request{
+		path:   make(map[string]string),
+		query:  make(url.Values),
+		header: make(http.Header),
+		form:   make(url.Values),
+	}
+}
+
+func (r *request) clone() *request {
+	c := newRequest()
+	for k, v := range r.path {
+		c.path[k] = v
+	}
+	for k, v := range r.query {
+		c.query[k] = v
+	}
+	for k, v := range r.header {
+		c.header[k] = v
+	}
+	for k, v := range r.form {
+		c.form[k] = v
+	}
+	c.files = append(c.files, r.files...)
+	c.body, c.hasBody = r.body, r.hasBody
+	return c
+}
+
+func (r *request) set(param *spec.Parameter, values ...string) {
+	switch param.In {
+	case "path":
+		r.path[param.Name] = strings.Join(values, ",")
+	case "query":
+		r.query[param.Name] = values
+	case "header":
+		r.header[http.CanonicalHeaderKey(param.Name)] = values
+	case "formData":
+		r.form[param.Name] = values
+	}
+}
+
+func (r *request) del(param *spec.Parameter) {
+	switch param.In {
+	case "path":
+		delete(r.path, param.Name)
+	case "query":
+		r.query.Del(param.Name)
+	case "header":
+		r.header.Del(param.Name)
+	case "formData":
+		if param.Type == "file" {
+			var files []string
+			for _, name := range r.files {
+				if name != param.Name {
+					files = append(files, name)
+				}
+			}
+			r.files = files
+			return
+		}
+		r.form.Del(param.Name)
+	case "body":
+		r.body, r.hasBody = nil, false
+	}
+}
+
+// validRequest a request with random values for the parameters, the optional file parameters are left out
+func validRequest(gen *fake.Generator, params []spec.Parameter) (*request, error) {
+	r := newRequest()
+	for i := range params {
+		param := &params[i]
+		if param.Type == "file" {
+			if param.Required {
+				r.files = append(r.files, param.Name)
+			}
+			continue
+		}
+
+		value, err := gen.Parameter(param)
+		if err != nil {
+			return nil, fmt.Errorf("the %s parameter %s: %v", param.In, param.Name, err)
+		}
+		if param.In == "body" {
+			r.body, r.hasBody = value, true
+			continue
+		}
+		r.set(param, formatParam(value, param.CollectionFormat, param.Items)...)
+	}
+	return r, nil
+}
+
+// formatParam the string values for a parameter value, an array is joined with the separator
+// for the collection format, the multi format gives a value for every item
+func formatParam(value interface{}, collectionFormat string, items *spec.Items) []string {
+	values, ok := value.([]interface{})
+	if !ok {
+		return []string{formatScalar(value)}
+	}
+
+	var format string
+	var next *spec.Items
+	if items != nil {
+		format, next = items.CollectionFormat, items.Items
+	}
+	parts := make([]string, 0, len(values))
+	for _, v := range values {
+		parts = append(parts, strings.Join(formatParam(v, format, next), ","))
+	}
+
+	switch collectionFormat {
+	case "multi":
+		return parts
+	case "ssv":
+		return []string{strings.Join(parts, " ")}
+	case "tsv":
+		return []string{strings.Join(parts, "\t")}
+	case "pipes":
+		return []string{strings.Join(parts, "|")}
+	}
+	return []string{strings.Join(parts, ",")}
+}
+
+func formatScalar(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case int32:
+		return strconv.FormatInt(int64(v), 10)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return fmt.Sprint(value)
+}
+
+type invalidRequest struct {
+	description string
+	request     *request
+}
+
+// invalidRequests the valid request with one mistake in the parameters
+func invalidRequests(sp *spec.Swagger, params []spec.Parameter, valid *request) []invalidRequest {
+	var result []invalidRequest
+	add := func(description string, r *request) {
+		result = append(result, invalidRequest{description: description, request: r})
+	}
+
+	for i := range params {
+		param := &params[i]
+		where := fmt.Sprintf("the %s parameter %s", param.In, param.Name)
+
+		if param.Required && param.In != "path" {
+			r := valid.clone()
+			r.del(param)
+			if param.In == "body" {
+				add("without the body", r)
+			} else {
+				add("without "+where, r)
+			}
+		}
+		if param.In == "body" {
+			result = append(result, invalidBodies(sp, param, valid)...)
+			continue
+		}
+		if param.Type == "file" || param.Type == "array" {
+			continue
+		}
+
+		with := func(description, value string) {
+			r := valid.clone()
+			r.set(param, value)
+			add(fmt.Sprintf("with %s %s", where, description), r)
+		}
+		switch param.Type {
+		case "integer", "number":
+			with("that isn't a number", "not-a-number")
+		case "boolean":
+			with("that isn't a boolean", "not-a-boolean")
+		}
+		if param.Maximum != nil {
+			with("above the maximum", formatScalar(*param.Maximum+1))
+		}
+		if param.Minimum != nil {
+			with("below the minimum", formatScalar(*param.Minimum-1))
+		}
+		if len(param.Enum) > 0 && param.Type == "string" {
+			value := "not-in-the-enum"
+			for enumContains(param.Enum, value) {
+				value += "-either"
+			}
+			with("outside the enum", value)
+		}
+		if param.MaxLength != nil {
+			with("longer than the max length", strings.Repeat("a", int(*param.MaxLength)+1))
+		}
+		// an empty path parameter would change the path of the request
+		if param.MinLength != nil && *param.MinLength > 0 && (*param.MinLength > 1 || param.In != "path") {
+			with("shorter than the min length", strings.Repeat("a", int(*param.MinLength)-1))
+		}
+	}
+	return result
+}
+
+// invalidBodies a body without a required property and a body of the wrong type
+func invalidBodies(sp *spec.Swagger, param *spec.Parameter, valid *request) []invalidRequest {
+	schema := param.Schema
+	if schema == nil {
+		return nil
+	}
+	if ref := schema.Ref.String(); strings.HasPrefix(ref, "#/definitions/") {
+		resolved, ok := sp.Definitions[strings.TrimPrefix(ref, "#/definitions/")]
+		if !ok {
+			return nil
+		}
+		schema = &resolved
+	}
+
+	var result []invalidRequest
+	if body, ok := valid.body.(map[string]interface{}); ok && len(schema.Required) > 0 {
+		required := append([]string(nil), schema.Required...)
+		sort.Strings(required)
+
+		missing := make(map[string]interface{}, len(body))
+		for k, v := range body {
+			if k != required[0] {
+				missing[k] = v
+			}
+		}
+		r := valid.clone()
+		r.body = missing
+		result = append(result, invalidRequest{description: "with a body without the required property " + required[0], request: r})
+	}
+	for _, tpe := range []string{"object", "array"} {
+		if schema.Type.Contains(tpe) {
+			r := valid.clone()
+			r.body = "not-an-" + tpe
+			result = append(result, invalidRequest{description: "with a body that isn't an " + tpe, request: r})
+			break
+		}
+	}
+	return result
+}
+
+func enumContains(enum []interface{}, value string) bool {
+	for _, v := range enum {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// send sends the request of the case to the server and reads the body of the response
+func (t *Tester) send(c Case) (*http.Response, []byte, error) {
+	r := c.request
+	pth := c.Path
+	for name, value := range r.path {
+		pth = strings.Replace(pth, "{"+name+"}", url.PathEscape(value), -1)
+	}
+	u := strings.TrimSuffix(t.BaseURL, "/") + strings.TrimSuffix(t.doc.BasePath(), "/") + pth
+	if len(r.query) > 0 {
+		u += "?" + r.query.Encode()
+	}
+
+	consumes := t.doc.ConsumesFor(c.operation)
+	var body io.Reader
+	var contentType string
+	switch {
+	case r.hasBody:
+		b, err := json.Marshal(r.body)
+		if err != nil {
+			return nil, nil, err
+		}
+		body, contentType = bytes.NewReader(b), mediaType(consumes, "json")
+		if contentType == "" {
+			contentType = httpkit.JSONMime
+		}
+	case len(r.files) > 0 || (len(r.form) > 0 && mediaType(consumes, httpkit.MultipartFormMime) != ""):
+		var buf bytes.Buffer
+		w := multipart.NewWriter(&buf)
+		for name, values := range r.form {
+			for _, value := range values {
+				w.WriteField(name, value)
+			}
+		}
+		for _, name := range r.files {
+			fw, err := w.CreateFormFile(name, name+".txt")
+			if err != nil {
+				return nil, nil, err
+			}
+			fw.Write([]byte("contract test"))
+		}
+		if err := w.Close(); err != nil {
+			return nil, nil, err
+		}
+		body, contentType = &buf, w.FormDataContentType()
+	case len(r.form) > 0:
+		body, contentType = strings.NewReader(r.form.Encode()), httpkit.URLencodedFormMime
+	}
+
+	req, err := http.NewRequest(c.Method, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	for name, values := range t.Header {
+		req.Header[http.CanonicalHeaderKey(name)] = values
+	}
+	for name, values := range r.header {
+		req.Header[name] = values
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if accept := mediaType(t.doc.ProducesFor(c.operation), "json"); accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	client := t.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	rsp, err := client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rsp.Body.Close()
+	b, err := ioutil.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rsp, b, nil
+}
+
+// mediaType the first media type that contains the part
+func mediaType(mediaTypes []string, part string) string {
+	for _, mt := range mediaTypes {
+		if strings.Contains(strings.ToLower(mt), part) {
+			return mt
+		}
+	}
+	return ""
+}
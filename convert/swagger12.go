@@ -0,0 +1,509 @@
+// Package convert turns swagger documents of older versions into swagger 2.0 specs
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/casualjim/go-swagger/spec"
+	"github.com/casualjim/go-swagger/swag"
+)
+
+// resourceListing the entry point of a swagger 1.2 api
+type resourceListing struct {
+	SwaggerVersion string                   `json:"swaggerVersion"`
+	APIVersion     string                   `json:"apiVersion"`
+	Info           *info12                  `json:"info"`
+	APIs           []resource               `json:"apis"`
+	Authorizations map[string]authorization `json:"authorizations"`
+}
+
+type info12 struct {
+	Title             string `json:"title"`
+	Description       string `json:"description"`
+	TermsOfServiceURL string `json:"termsOfServiceUrl"`
+	Contact           string `json:"contact"`
+	License           string `json:"license"`
+	LicenseURL        string `json:"licenseUrl"`
+}
+
+type resource struct {
+	Path        string `json:"path"`
+	Description string `json:"description"`
+}
+
+type authorization struct {
+	Type       string                `json:"type"`
+	PassAs     string                `json:"passAs"`
+	Keyname    string                `json:"keyname"`
+	Scopes     []scope               `json:"scopes"`
+	GrantTypes map[string]grantTypes `json:"grantTypes"`
+}
+
+type scope struct {
+	Scope       string `json:"scope"`
+	Description string `json:"description"`
+}
+
+type grantTypes struct {
+	LoginEndpoint        *endpoint `json:"loginEndpoint"`
+	TokenRequestEndpoint *endpoint `json:"tokenRequestEndpoint"`
+	TokenEndpoint        *endpoint `json:"tokenEndpoint"`
+}
+
+type endpoint struct {
+	URL string `json:"url"`
+}
+
+// apiDeclaration describes the operations and models of a single resource
+type apiDeclaration struct {
+	SwaggerVersion string             `json:"swaggerVersion"`
+	BasePath       string             `json:"basePath"`
+	ResourcePath   string             `json:"resourcePath"`
+	Produces       []string           `json:"produces"`
+	Consumes       []string           `json:"consumes"`
+	APIs           []api              `json:"apis"`
+	Models         map[string]model12 `json:"models"`
+}
+
+type api struct {
+	Path       string        `json:"path"`
+	Operations []operation12 `json:"operations"`
+}
+
+// dataType the fields 1.2 uses to describe the type of parameters, properties and return values
+type dataType struct {
+	Type         string        `json:"type"`
+	Ref          string        `json:"$ref"`
+	Format       string        `json:"format"`
+	DefaultValue interface{}   `json:"defaultValue"`
+	Enum         []interface{} `json:"enum"`
+	Minimum      string        `json:"minimum"`
+	Maximum      string        `json:"maximum"`
+	Items        *dataType     `json:"items"`
+	UniqueItems  bool          `json:"uniqueItems"`
+}
+
+type operation12 struct {
+	dataType
+	Method           string             `json:"method"`
+	Summary          string             `json:"summary"`
+	Notes            string             `json:"notes"`
+	Nickname         string             `json:"nickname"`
+	Produces         []string           `json:"produces"`
+	Consumes         []string           `json:"consumes"`
+	Authorizations   map[string][]scope `json:"authorizations"`
+	Parameters       []parameter12      `json:"parameters"`
+	ResponseMessages []responseMessage  `json:"responseMessages"`
+	Deprecated       interface{}        `json:"deprecated"`
+}
+
+type parameter12 struct {
+	dataType
+	ParamType     string `json:"paramType"`
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	Required      bool   `json:"required"`
+	AllowMultiple bool   `json:"allowMultiple"`
+}
+
+type responseMessage struct {
+	Code          int    `json:"code"`
+	Message       string `json:"message"`
+	ResponseModel string `json:"responseModel"`
+}
+
+type model12 struct {
+	ID            string                `json:"id"`
+	Description   string                `json:"description"`
+	Required      []string              `json:"required"`
+	Properties    map[string]property12 `json:"properties"`
+	SubTypes      []string              `json:"subTypes"`
+	Discriminator string                `json:"discriminator"`
+}
+
+type property12 struct {
+	dataType
+	Description string `json:"description"`
+}
+
+// Swagger12 loads a swagger 1.2 resource listing and the api declarations it lists and converts them
+// into a swagger 2.0 spec.
+//
+// The listing can be a file or an http url. The api declarations are looked up relative to the listing:
+// for an http url the path of the api gets appended to the url of the listing, for a file it's relative to the
+// directory of the listing and a .json extension is added when there is no file without the extension.
+func Swagger12(listing string) (*spec.Swagger, error) {
+	var rl resourceListing
+	if err := load(listing, &rl); err != nil {
+		return nil, err
+	}
+	if rl.SwaggerVersion != "1.2" {
+		return nil, fmt.Errorf("expected swagger version 1.2 for %q but got %q", listing, rl.SwaggerVersion)
+	}
+
+	c := &converter{sp: new(spec.Swagger)}
+	c.sp.Swagger = "2.0"
+	c.sp.Paths = &spec.Paths{Paths: make(map[string]spec.PathItem)}
+	c.sp.Definitions = make(spec.Definitions)
+	c.info(&rl)
+	c.authorizations(rl.Authorizations)
+
+	for _, res := range rl.APIs {
+		location := declarationLocation(listing, res.Path)
+		var decl apiDeclaration
+		if err := load(location, &decl); err != nil {
+			return nil, err
+		}
+		tag := strings.Trim(decl.ResourcePath, "/")
+		if tag == "" {
+			tag = strings.Trim(strings.Replace(res.Path, ".{format}", "", -1), "/")
+		}
+		if tag != "" {
+			c.sp.Tags = append(c.sp.Tags, spec.NewTag(tag, res.Description, nil))
+		}
+		if err := c.declaration(tag, &decl); err != nil {
+			return nil, fmt.Errorf("%s: %v", location, err)
+		}
+	}
+	return c.sp, nil
+}
+
+func load(location string, target interface{}) error {
+	b, err := swag.LoadFromFileOrHTTP(location)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, target)
+}
+
+func declarationLocation(listing, apiPath string) string {
+	apiPath = strings.Replace(apiPath, "{format}", "json", -1)
+	if strings.HasPrefix(listing, "http") {
+		return strings.TrimSuffix(listing, "/") + apiPath
+	}
+	location := filepath.Join(filepath.Dir(listing), filepath.FromSlash(apiPath))
+	if _, err := os.Stat(location); err != nil && filepath.Ext(location) == "" {
+		return location + ".json"
+	}
+	return location
+}
+
+type converter struct {
+	sp *spec.Swagger
+}
+
+func (c *converter) info(rl *resourceListing) {
+	c.sp.Info = new(spec.Info)
+	c.sp.Info.Version = rl.APIVersion
+	if rl.Info == nil {
+		return
+	}
+	c.sp.Info.Title = rl.Info.Title
+	c.sp.Info.Description = rl.Info.Description
+	c.sp.Info.TermsOfService = rl.Info.TermsOfServiceURL
+	if rl.Info.Contact != "" {
+		c.sp.Info.Contact = &spec.ContactInfo{Email: rl.Info.Contact}
+	}
+	if rl.Info.License != "" || rl.Info.LicenseURL != "" {
+		c.sp.Info.License = &spec.License{Name: rl.Info.License, URL: rl.Info.LicenseURL}
+	}
+}
+
+func (c *converter) authorizations(auths map[string]authorization) {
+	var names []string
+	for name := range auths {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		auth := auths[name]
+		var scheme *spec.SecurityScheme
+		switch auth.Type {
+		case "basicAuth":
+			scheme = spec.BasicAuth()
+		case "apiKey":
+			scheme = spec.APIKeyAuth(auth.Keyname, auth.PassAs)
+		case "oauth2":
+			if implicit, ok := auth.GrantTypes["implicit"]; ok && implicit.LoginEndpoint != nil {
+				scheme = spec.OAuth2Implicit(implicit.LoginEndpoint.URL)
+			} else if code, ok := auth.GrantTypes["authorization_code"]; ok && code.TokenRequestEndpoint != nil && code.TokenEndpoint != nil {
+				scheme = spec.OAuth2AccessToken(code.TokenRequestEndpoint.URL, code.TokenEndpoint.URL)
+			} else {
+				continue
+			}
+			for _, s := range auth.Scopes {
+				scheme.AddScope(s.Scope, s.Description)
+			}
+		default:
+			continue
+		}
+		if c.sp.SecurityDefinitions == nil {
+			c.sp.SecurityDefinitions = make(spec.SecurityDefinitions)
+		}
+		c.sp.SecurityDefinitions[name] = scheme
+	}
+}
+
+func (c *converter) declaration(tag string, decl *apiDeclaration) error {
+	if decl.BasePath != "" && c.sp.Host == "" {
+		u, err := url.Parse(decl.BasePath)
+		if err != nil {
+			return err
+		}
+		c.sp.Host = u.Host
+		c.sp.BasePath = u.Path
+		if u.Scheme != "" {
+			c.sp.Schemes = []string{u.Scheme}
+		}
+	}
+
+	for _, a := range decl.APIs {
+		// the format suffix picks the media type in 1.2, in 2.0 that's up to the accept header
+		pth := strings.Replace(a.Path, ".{format}", "", -1)
+		item := c.sp.Paths.Paths[pth]
+		for _, op12 := range a.Operations {
+			op, err := c.operation(tag, decl, &op12)
+			if err != nil {
+				return err
+			}
+			if pth != a.Path {
+				var params []spec.Parameter
+				for _, param := range op.Parameters {
+					if param.In != "path" || param.Name != "format" {
+						params = append(params, param)
+					}
+				}
+				op.Parameters = params
+			}
+			switch strings.ToUpper(op12.Method) {
+			case "GET":
+				item.Get = op
+			case "PUT":
+				item.Put = op
+			case "POST":
+				item.Post = op
+			case "DELETE":
+				item.Delete = op
+			case "OPTIONS":
+				item.Options = op
+			case "HEAD":
+				item.Head = op
+			case "PATCH":
+				item.Patch = op
+			default:
+				return fmt.Errorf("unsupported method %q for %s", op12.Method, a.Path)
+			}
+		}
+		c.sp.Paths.Paths[pth] = item
+	}
+
+	for name, m := range decl.Models {
+		c.model(name, m, decl.Models)
+	}
+	return nil
+}
+
+func (c *converter) operation(tag string, decl *apiDeclaration, op12 *operation12) (*spec.Operation, error) {
+	op := new(spec.Operation)
+	op.ID = op12.Nickname
+	op.Summary = op12.Summary
+	op.Description = op12.Notes
+	if tag != "" {
+		op.Tags = []string{tag}
+	}
+	op.Produces = op12.Produces
+	if len(op.Produces) == 0 {
+		op.Produces = decl.Produces
+	}
+	op.Consumes = op12.Consumes
+	if len(op.Consumes) == 0 {
+		op.Consumes = decl.Consumes
+	}
+	switch deprecated := op12.Deprecated.(type) {
+	case bool:
+		op.Deprecated = deprecated
+	case string:
+		op.Deprecated = deprecated == "true"
+	}
+
+	var auths []string
+	for name := range op12.Authorizations {
+		auths = append(auths, name)
+	}
+	sort.Strings(auths)
+	for _, name := range auths {
+		scopes := []string{}
+		for _, s := range op12.Authorizations[name] {
+			scopes = append(scopes, s.Scope)
+		}
+		op.Security = append(op.Security, map[string][]string{name: scopes})
+	}
+
+	for _, p12 := range op12.Parameters {
+		param, err := parameter(&p12)
+		if err != nil {
+			return nil, err
+		}
+		op.Parameters = append(op.Parameters, *param)
+	}
+
+	op.Responses = new(spec.Responses)
+	op.Responses.StatusCodeResponses = make(map[int]spec.Response)
+	if (op12.Type != "" && op12.Type != "void") || op12.Ref != "" {
+		var rsp spec.Response
+		rsp.Description = "successful operation"
+		rsp.Schema = schema(&op12.dataType)
+		op.Responses.StatusCodeResponses[200] = rsp
+	}
+	for _, msg := range op12.ResponseMessages {
+		var rsp spec.Response
+		rsp.Description = msg.Message
+		if msg.ResponseModel != "" {
+			rsp.Schema = schema(&dataType{Type: msg.ResponseModel})
+		}
+		op.Responses.StatusCodeResponses[msg.Code] = rsp
+	}
+	if len(op.Responses.StatusCodeResponses) == 0 {
+		var rsp spec.Response
+		rsp.Description = "successful operation"
+		op.Responses.Default = &rsp
+	}
+	return op, nil
+}
+
+func parameter(p12 *parameter12) (*spec.Parameter, error) {
+	param := new(spec.Parameter)
+	param.Name = p12.Name
+	param.Description = p12.Description
+	param.Required = p12.Required
+	param.In = p12.ParamType
+	if param.In == "form" {
+		param.In = "formData"
+	}
+
+	if param.In == "body" {
+		param.Schema = schema(&p12.dataType)
+		return param, nil
+	}
+
+	tpe, format := primitive(&p12.dataType)
+	if tpe == "" {
+		return nil, fmt.Errorf("parameter %q of type %q can only be used as body parameter", p12.Name, p12.Type)
+	}
+	var err error
+	if param.Minimum, err = number(p12.Minimum); err != nil {
+		return nil, err
+	}
+	if param.Maximum, err = number(p12.Maximum); err != nil {
+		return nil, err
+	}
+	param.Enum = p12.Enum
+	param.Default = p12.DefaultValue
+
+	if tpe == "array" || p12.AllowMultiple {
+		items := new(spec.Items)
+		if tpe == "array" && p12.Items != nil {
+			items.Type, items.Format = primitive(p12.Items)
+		} else {
+			items.Type, items.Format = tpe, format
+			items.Enum, param.Enum = param.Enum, nil
+		}
+		param.Type = "array"
+		param.Items = items
+		param.CollectionFormat = "csv"
+		param.UniqueItems = p12.UniqueItems
+		return param, nil
+	}
+	param.Type, param.Format = tpe, format
+	return param, nil
+}
+
+func (c *converter) model(name string, m model12, models map[string]model12) {
+	sch := new(spec.Schema)
+	sch.Typed("object", "")
+	sch.Description = m.Description
+	sch.Required = m.Required
+	sch.Discriminator = m.Discriminator
+	for propName, prop := range m.Properties {
+		ps := schema(&prop.dataType)
+		ps.Description = prop.Description
+		sch.SetProperty(propName, *ps)
+	}
+
+	// a model that is the sub type of another model is composed with that model
+	for parentName, parent := range models {
+		for _, sub := range parent.SubTypes {
+			if sub == name {
+				*sch = *new(spec.Schema).WithAllOf(*spec.RefProperty("#/definitions/" + parentName), *sch)
+			}
+		}
+	}
+	c.sp.Definitions[name] = *sch
+}
+
+// schema converts the 1.2 type to a schema, a type that isn't a primitive refers to a model
+func schema(dt *dataType) *spec.Schema {
+	if dt.Ref != "" {
+		return spec.RefProperty("#/definitions/" + dt.Ref)
+	}
+	tpe, format := primitive(dt)
+	switch tpe {
+	case "":
+		return spec.RefProperty("#/definitions/" + dt.Type)
+	case "array":
+		items := new(spec.Schema)
+		if dt.Items != nil {
+			items = schema(dt.Items)
+		}
+		sch := spec.ArrayProperty(items)
+		if dt.UniqueItems {
+			sch.UniqueValues()
+		}
+		return sch
+	}
+
+	sch := new(spec.Schema).Typed(tpe, format)
+	sch.Enum = dt.Enum
+	sch.Default = dt.DefaultValue
+	sch.Minimum, _ = number(dt.Minimum)
+	sch.Maximum, _ = number(dt.Maximum)
+	return sch
+}
+
+// primitive the swagger 2.0 type and format for a 1.2 type, the type is empty for models
+func primitive(dt *dataType) (string, string) {
+	switch dt.Type {
+	case "integer", "number", "string", "boolean", "array":
+		return dt.Type, dt.Format
+	case "int", "long":
+		return "integer", map[string]string{"int": "int32", "long": "int64"}[dt.Type]
+	case "float", "double":
+		return "number", dt.Type
+	case "byte", "date", "date-time":
+		return "string", dt.Type
+	case "File", "file":
+		return "file", ""
+	case "List", "Set":
+		return "array", ""
+	}
+	return "", ""
+}
+
+func number(value string) (*float64, error) {
+	if value == "" {
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, fmt.Errorf("expected a number but got %q", value)
+	}
+	return &f, nil
+}
@@ -0,0 +1,153 @@
+package convert
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/casualjim/go-swagger/spec"
+	"github.com/casualjim/go-swagger/strfmt"
+	"github.com/casualjim/go-swagger/validate"
+	"github.com/stretchr/testify/assert"
+)
+
+func verifyConvertedPetstore(t testing.TB, sp *spec.Swagger) {
+	assert.Equal(t, "2.0", sp.Swagger)
+	assert.Equal(t, "petstore.swagger.wordnik.com", sp.Host)
+	assert.Equal(t, "/api", sp.BasePath)
+	assert.Equal(t, []string{"http"}, sp.Schemes)
+
+	if assert.NotNil(t, sp.Info) {
+		assert.Equal(t, "Swagger Sample App", sp.Info.Title)
+		assert.Equal(t, "1.0.0", sp.Info.Version)
+		assert.Equal(t, "apiteam@wordnik.com", sp.Info.Contact.Email)
+		assert.Equal(t, "Apache 2.0", sp.Info.License.Name)
+	}
+	if assert.Len(t, sp.Tags, 2) {
+		assert.Equal(t, "pet", sp.Tags[0].Name)
+		assert.Equal(t, "Operations about pets", sp.Tags[0].Description)
+	}
+
+	if assert.Len(t, sp.SecurityDefinitions, 2) {
+		oauth := sp.SecurityDefinitions["oauth2"]
+		assert.Equal(t, "implicit", oauth.Flow)
+		assert.Equal(t, "http://petstore.swagger.wordnik.com/oauth/dialog", oauth.AuthorizationURL)
+		assert.Len(t, oauth.Scopes, 2)
+		apiKey := sp.SecurityDefinitions["api_key"]
+		assert.Equal(t, "header", apiKey.In)
+		assert.Equal(t, "api_key", apiKey.Name)
+	}
+
+	pet := sp.Paths.Paths["/pet/{petId}"]
+	if assert.NotNil(t, pet.Get) {
+		assert.Equal(t, "getPetById", pet.Get.ID)
+		assert.Equal(t, []string{"pet"}, pet.Get.Tags)
+		assert.Equal(t, []string{"application/json", "application/xml"}, pet.Get.Produces)
+		param := pet.Get.Parameters[0]
+		assert.Equal(t, "path", param.In)
+		assert.Equal(t, "integer", param.Type)
+		assert.Equal(t, "int64", param.Format)
+		if assert.NotNil(t, param.Maximum) {
+			assert.EqualValues(t, 100000, *param.Maximum)
+		}
+		ok := pet.Get.Responses.StatusCodeResponses[200]
+		assert.Equal(t, "#/definitions/Pet", ok.Schema.Ref.String())
+		notFound := pet.Get.Responses.StatusCodeResponses[404]
+		assert.Equal(t, "Pet not found", notFound.Description)
+		assert.Equal(t, "#/definitions/ApiError", notFound.Schema.Ref.String())
+	}
+	if assert.NotNil(t, pet.Delete) {
+		assert.True(t, pet.Delete.Deprecated)
+		assert.Equal(t, []map[string][]string{{"oauth2": {"write:pets"}}}, pet.Delete.Security)
+		assert.NotNil(t, pet.Delete.Responses.Default)
+	}
+
+	find := sp.Paths.Paths["/pet/findByStatus"].Get
+	if assert.NotNil(t, find) {
+		status := find.Parameters[0]
+		assert.Equal(t, "array", status.Type)
+		assert.Equal(t, "csv", status.CollectionFormat)
+		assert.Equal(t, "string", status.Items.Type)
+		assert.Len(t, status.Items.Enum, 3)
+		rsp := find.Responses.StatusCodeResponses[200]
+		assert.Equal(t, "#/definitions/Pet", rsp.Schema.Items.Schema.Ref.String())
+	}
+
+	add := sp.Paths.Paths["/pet"].Post
+	if assert.NotNil(t, add) {
+		assert.Equal(t, "body", add.Parameters[0].In)
+		assert.Equal(t, "#/definitions/Pet", add.Parameters[0].Schema.Ref.String())
+		assert.Equal(t, []string{"application/json"}, add.Consumes)
+	}
+	// the format suffix and its parameter are dropped
+	assert.NotContains(t, sp.Paths.Paths, "/store/order.{format}")
+	order := sp.Paths.Paths["/store/order"].Post
+	if assert.NotNil(t, order) {
+		assert.Equal(t, []map[string][]string{{"api_key": {}}}, order.Security)
+		if assert.Len(t, order.Parameters, 1) {
+			assert.Equal(t, "body", order.Parameters[0].In)
+		}
+	}
+
+	assert.Len(t, sp.Definitions, 5)
+	petModel := sp.Definitions["Pet"]
+	assert.Equal(t, "kind", petModel.Discriminator)
+	assert.Equal(t, []string{"id", "name"}, petModel.Required)
+	dog := sp.Definitions["Dog"]
+	if assert.Len(t, dog.AllOf, 2) {
+		assert.Equal(t, "#/definitions/Pet", dog.AllOf[0].Ref.String())
+		assert.Contains(t, dog.AllOf[1].Properties, "barks")
+	}
+}
+
+func TestSwagger12(t *testing.T) {
+	sp, err := Swagger12("../fixtures/swagger12/api-docs.json")
+	if assert.NoError(t, err) {
+		verifyConvertedPetstore(t, sp)
+	}
+}
+
+func TestSwagger12OverHTTP(t *testing.T) {
+	files := map[string]string{
+		"/api-docs":       "../fixtures/swagger12/api-docs.json",
+		"/api-docs/pet":   "../fixtures/swagger12/pet.json",
+		"/api-docs/store": "../fixtures/swagger12/store.json",
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		file, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(rw, r)
+			return
+		}
+		http.ServeFile(rw, r, file)
+	}))
+	defer server.Close()
+
+	sp, err := Swagger12(server.URL + "/api-docs")
+	if assert.NoError(t, err) {
+		verifyConvertedPetstore(t, sp)
+	}
+}
+
+func TestSwagger12Validates(t *testing.T) {
+	sp, err := Swagger12("../fixtures/swagger12/api-docs.json")
+	if !assert.NoError(t, err) {
+		return
+	}
+	b, err := sp.MarshalJSON()
+	if !assert.NoError(t, err) {
+		return
+	}
+	doc, err := spec.New(b, "")
+	if assert.NoError(t, err) {
+		assert.NoError(t, validate.Spec(doc, strfmt.Default))
+	}
+}
+
+func TestSwagger12Errors(t *testing.T) {
+	_, err := Swagger12("../fixtures/swagger12/does-not-exist.json")
+	assert.Error(t, err)
+
+	_, err = Swagger12("../fixtures/codegen/swagger-codegen-tests.json")
+	assert.Error(t, err)
+}
@@ -0,0 +1,530 @@
+// Package diff compares two versions of a swagger spec and classifies the changes
+// as breaking or compatible for the clients of the API.
+//
+// How a change to a schema is classified depends on the direction it travels in. A schema that
+// accepts fewer values than before breaks the clients that send it in a request, while it's
+// compatible for the clients that receive it in a response. A schema that accepts more values
+// breaks the clients of a response and is compatible for a request. A definition is classified
+// for the directions it's used in by the operations of either version, a definition that isn't
+// used by any operation is classified for both.
+//
+// These changes are breaking:
+//
+//   - an operation, a response, a response header or a definition is removed
+//   - a parameter is removed, a required parameter is added or a parameter becomes required
+//   - the type, the format or the ref of a parameter, a header, a property or a schema changes
+//   - in a request: values are removed from an enum, a schema, a required property or an allOf
+//     schema is added, a property becomes required or additional properties are no longer allowed
+//   - in a response: values are added to an enum, a property or an allOf schema is removed,
+//     a property becomes optional or additional properties become allowed
+//
+// Everything else is a compatible change, like new operations, responses, headers, definitions,
+// optional parameters and properties.
+package diff
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/casualjim/go-swagger/jsonpointer"
+	"github.com/casualjim/go-swagger/spec"
+)
+
+// Change is a difference between two versions of a spec
+type Change struct {
+	// Location is the json pointer to the changed object in the new version of the spec,
+	// or in the old version when the object was removed. The parameters are pointed at by their
+	// index in the parameters of the operation or the path item they are declared on. A change
+	// to a shared parameter or response is pointed at where an operation refers to it.
+	Location string `json:"location"`
+	// Description tells what changed
+	Description string `json:"description"`
+	// Breaking is true when the change breaks the clients of the previous version
+	Breaking bool `json:"breaking"`
+}
+
+func (c Change) String() string {
+	kind := "compatible"
+	if c.Breaking {
+		kind = "breaking"
+	}
+	return fmt.Sprintf("%s %s: %s", kind, c.Location, c.Description)
+}
+
+// Changes the changes between two versions of a spec
+type Changes []Change
+
+// Breaking returns true when any of the changes is breaking
+func (c Changes) Breaking() bool {
+	for _, change := range c {
+		if change.Breaking {
+			return true
+		}
+	}
+	return false
+}
+
+// Compare compares the operations and definitions of the new version of a spec with the old version
+func Compare(old, new *spec.Document) Changes {
+	d := &differ{old: old.Spec(), new: new.Spec(), usage: make(map[string]direction)}
+	d.use(d.old)
+	d.use(d.new)
+	d.operations(old.Operations(), new.Operations())
+	d.definitions()
+	return d.changes
+}
+
+// direction the directions a schema travels in, a schema can be used in both
+type direction int
+
+const (
+	request direction = 1 << iota
+	response
+)
+
+type differ struct {
+	old     *spec.Swagger
+	new     *spec.Swagger
+	usage   map[string]direction
+	changes Changes
+}
+
+func (d *differ) breaking(location, format string, args ...interface{}) {
+	d.changes = append(d.changes, Change{Location: location, Description: fmt.Sprintf(format, args...), Breaking: true})
+}
+
+func (d *differ) compatible(location, format string, args ...interface{}) {
+	d.changes = append(d.changes, Change{Location: location, Description: fmt.Sprintf(format, args...)})
+}
+
+// narrowed reports a change that makes a schema accept fewer values, it breaks the requests
+func (d *differ) narrowed(location string, dir direction, format string, args ...interface{}) {
+	d.changes = append(d.changes, Change{Location: location, Description: fmt.Sprintf(format, args...), Breaking: dir&request != 0})
+}
+
+// widened reports a change that makes a schema accept more values, it breaks the responses
+func (d *differ) widened(location string, dir direction, format string, args ...interface{}) {
+	d.changes = append(d.changes, Change{Location: location, Description: fmt.Sprintf(format, args...), Breaking: dir&response != 0})
+}
+
+// use records the directions the definitions are used in by the operations of the spec,
+// a definition that is used by another definition gets the directions of that definition
+func (d *differ) use(sp *spec.Swagger) {
+	if sp.Paths == nil {
+		return
+	}
+	for _, item := range sp.Paths.Paths {
+		for _, param := range item.Parameters {
+			d.useSchema(sp, param.Schema, request)
+		}
+		for _, op := range []*spec.Operation{item.Get, item.Put, item.Post, item.Delete, item.Options, item.Head, item.Patch} {
+			if op == nil {
+				continue
+			}
+			for _, param := range op.Parameters {
+				if ref := param.Ref.String(); strings.HasPrefix(ref, "#/parameters/") {
+					param = sp.Parameters[jsonpointer.Unescape(strings.TrimPrefix(ref, "#/parameters/"))]
+				}
+				d.useSchema(sp, param.Schema, request)
+			}
+			if op.Responses == nil {
+				continue
+			}
+			rsps := make([]spec.Response, 0, len(op.Responses.StatusCodeResponses)+1)
+			for _, rsp := range op.Responses.StatusCodeResponses {
+				rsps = append(rsps, rsp)
+			}
+			if op.Responses.Default != nil {
+				rsps = append(rsps, *op.Responses.Default)
+			}
+			for _, rsp := range rsps {
+				rsp = resolveResponse(sp, rsp)
+				d.useSchema(sp, rsp.Schema, response)
+			}
+		}
+	}
+}
+
+func (d *differ) useSchema(sp *spec.Swagger, sch *spec.Schema, dir direction) {
+	if sch == nil {
+		return
+	}
+	if ref := sch.Ref.String(); strings.HasPrefix(ref, "#/definitions/") {
+		name := jsonpointer.Unescape(strings.TrimPrefix(ref, "#/definitions/"))
+		if d.usage[name]&dir == dir {
+			return
+		}
+		d.usage[name] |= dir
+		if def, ok := sp.Definitions[name]; ok {
+			d.useSchema(sp, &def, dir)
+		}
+		return
+	}
+	for _, prop := range sch.Properties {
+		d.useSchema(sp, &prop, dir)
+	}
+	for i := range sch.AllOf {
+		d.useSchema(sp, &sch.AllOf[i], dir)
+	}
+	if sch.Items != nil {
+		d.useSchema(sp, sch.Items.Schema, dir)
+		for i := range sch.Items.Schemas {
+			d.useSchema(sp, &sch.Items.Schemas[i], dir)
+		}
+	}
+	if sch.AdditionalProperties != nil {
+		d.useSchema(sp, sch.AdditionalProperties.Schema, dir)
+	}
+}
+
+func operationLocation(method, path string) string {
+	return "/paths/" + jsonpointer.Escape(path) + "/" + strings.ToLower(method)
+}
+
+func (d *differ) operations(old, new map[string]map[string]*spec.Operation) {
+	for _, method := range sortedKeys(old) {
+		for _, path := range sortedKeys(old[method]) {
+			location := operationLocation(method, path)
+			newOp, ok := new[method][path]
+			if !ok {
+				d.breaking(location, "the operation was removed")
+				continue
+			}
+			d.parameters(d.paramsFor(d.old, method, path, old[method][path]), d.paramsFor(d.new, method, path, newOp))
+			d.responses(location, old[method][path].Responses, newOp.Responses)
+		}
+	}
+	for _, method := range sortedKeys(new) {
+		for _, path := range sortedKeys(new[method]) {
+			if _, ok := old[method][path]; !ok {
+				d.compatible(operationLocation(method, path), "the operation was added")
+			}
+		}
+	}
+}
+
+// param a parameter of an operation with the json pointer to where it's declared
+type param struct {
+	spec.Parameter
+	location string
+}
+
+// paramsFor the parameters of the operation by location and name, refs to parameters of the spec are resolved.
+// The parameters of the operation override the ones of its path item.
+func (d *differ) paramsFor(sp *spec.Swagger, method, path string, op *spec.Operation) map[string]param {
+	params := make(map[string]param)
+	add := func(location string, list []spec.Parameter) {
+		for i, p := range list {
+			if ref := p.Ref.String(); strings.HasPrefix(ref, "#/parameters/") {
+				if resolved, ok := sp.Parameters[jsonpointer.Unescape(strings.TrimPrefix(ref, "#/parameters/"))]; ok {
+					p = resolved
+				}
+			}
+			params[p.In+"/"+p.Name] = param{Parameter: p, location: fmt.Sprintf("%s/parameters/%d", location, i)}
+		}
+	}
+	if sp.Paths != nil {
+		add("/paths/"+jsonpointer.Escape(path), sp.Paths.Paths[path].Parameters)
+	}
+	add(operationLocation(method, path), op.Parameters)
+	return params
+}
+
+func (d *differ) parameters(old, new map[string]param) {
+	for _, key := range sortedKeys(old) {
+		oldParam := old[key]
+		newParam, ok := new[key]
+		if !ok {
+			d.breaking(oldParam.location, "the parameter was removed")
+			continue
+		}
+		location := newParam.location
+		if newParam.Required && !oldParam.Required {
+			d.breaking(location, "the parameter became required")
+		} else if oldParam.Required && !newParam.Required {
+			d.compatible(location, "the parameter became optional")
+		}
+		if oldParam.In == "body" {
+			d.schema(location+"/schema", request, oldParam.Schema, newParam.Schema)
+			continue
+		}
+		d.simpleType(location, oldParam.Type, oldParam.Format, newParam.Type, newParam.Format)
+		d.enum(location, request, oldParam.Enum, newParam.Enum)
+		d.items(location+"/items", request, oldParam.Items, newParam.Items)
+	}
+	for _, key := range sortedKeys(new) {
+		if _, ok := old[key]; ok {
+			continue
+		}
+		if new[key].Required {
+			d.breaking(new[key].location, "a required parameter was added")
+		} else {
+			d.compatible(new[key].location, "an optional parameter was added")
+		}
+	}
+}
+
+func (d *differ) items(location string, dir direction, old, new *spec.Items) {
+	if old == nil || new == nil {
+		if old != nil || new != nil {
+			d.breaking(location, "the items changed")
+		}
+		return
+	}
+	d.simpleType(location, old.Type, old.Format, new.Type, new.Format)
+	d.enum(location, dir, old.Enum, new.Enum)
+	d.items(location+"/items", dir, old.Items, new.Items)
+}
+
+// resolveResponse follows a ref to the responses of the spec
+func resolveResponse(sp *spec.Swagger, rsp spec.Response) spec.Response {
+	if ref := rsp.Ref.String(); strings.HasPrefix(ref, "#/responses/") {
+		if resolved, ok := sp.Responses[jsonpointer.Unescape(strings.TrimPrefix(ref, "#/responses/"))]; ok {
+			return resolved
+		}
+	}
+	return rsp
+}
+
+// responses compares the responses of an operation, refs to responses of the spec are resolved
+// so a change to a shared response is reported for every operation that uses it
+func (d *differ) responses(location string, old, new *spec.Responses) {
+	oldResponses, newResponses := make(map[string]spec.Response), make(map[string]spec.Response)
+	if old != nil {
+		if old.Default != nil {
+			oldResponses["default"] = *old.Default
+		}
+		for code, rsp := range old.StatusCodeResponses {
+			oldResponses[fmt.Sprint(code)] = rsp
+		}
+	}
+	if new != nil {
+		if new.Default != nil {
+			newResponses["default"] = *new.Default
+		}
+		for code, rsp := range new.StatusCodeResponses {
+			newResponses[fmt.Sprint(code)] = rsp
+		}
+	}
+
+	for _, code := range sortedKeys(oldResponses) {
+		rspLocation := location + "/responses/" + code
+		newRsp, ok := newResponses[code]
+		if !ok {
+			d.breaking(rspLocation, "the response was removed")
+			continue
+		}
+		oldRsp, newRsp := resolveResponse(d.old, oldResponses[code]), resolveResponse(d.new, newRsp)
+		d.schema(rspLocation+"/schema", response, oldRsp.Schema, newRsp.Schema)
+		d.headers(rspLocation+"/headers", oldRsp.Headers, newRsp.Headers)
+	}
+	for _, code := range sortedKeys(newResponses) {
+		if _, ok := oldResponses[code]; !ok {
+			d.compatible(location+"/responses/"+code, "the response was added")
+		}
+	}
+}
+
+func (d *differ) headers(location string, old, new map[string]spec.Header) {
+	for _, name := range sortedKeys(old) {
+		headerLocation := location + "/" + jsonpointer.Escape(name)
+		newHeader, ok := new[name]
+		if !ok {
+			d.breaking(headerLocation, "the header was removed")
+			continue
+		}
+		oldHeader := old[name]
+		d.simpleType(headerLocation, oldHeader.Type, oldHeader.Format, newHeader.Type, newHeader.Format)
+		d.enum(headerLocation, response, oldHeader.Enum, newHeader.Enum)
+		d.items(headerLocation+"/items", response, oldHeader.Items, newHeader.Items)
+	}
+	for _, name := range sortedKeys(new) {
+		if _, ok := old[name]; !ok {
+			d.compatible(location+"/"+jsonpointer.Escape(name), "the header was added")
+		}
+	}
+}
+
+func (d *differ) definitions() {
+	for _, name := range sortedKeys(d.old.Definitions) {
+		location := "/definitions/" + jsonpointer.Escape(name)
+		newSchema, ok := d.new.Definitions[name]
+		if !ok {
+			d.breaking(location, "the definition was removed")
+			continue
+		}
+		dir := d.usage[name]
+		if dir == 0 {
+			dir = request | response
+		}
+		oldSchema := d.old.Definitions[name]
+		d.schema(location, dir, &oldSchema, &newSchema)
+	}
+	for _, name := range sortedKeys(d.new.Definitions) {
+		if _, ok := d.old.Definitions[name]; !ok {
+			d.compatible("/definitions/"+jsonpointer.Escape(name), "the definition was added")
+		}
+	}
+}
+
+func (d *differ) schema(location string, dir direction, old, new *spec.Schema) {
+	if old == nil || new == nil {
+		if old != nil {
+			d.breaking(location, "the schema was removed")
+		} else if new != nil {
+			d.narrowed(location, dir, "a schema was added")
+		}
+		return
+	}
+	if oldRef, newRef := old.Ref.String(), new.Ref.String(); oldRef != newRef {
+		d.breaking(location, "the ref changed from %q to %q", oldRef, newRef)
+		return
+	}
+	if !reflect.DeepEqual(old.Type, new.Type) {
+		d.breaking(location, "the type changed from %q to %q", strings.Join(old.Type, ","), strings.Join(new.Type, ","))
+	} else if old.Format != new.Format {
+		d.breaking(location, "the format changed from %q to %q", old.Format, new.Format)
+	}
+	d.enum(location, dir, old.Enum, new.Enum)
+
+	required := func(sch *spec.Schema, name string) bool {
+		for _, req := range sch.Required {
+			if req == name {
+				return true
+			}
+		}
+		return false
+	}
+	for _, name := range sortedKeys(old.Properties) {
+		propLocation := location + "/properties/" + jsonpointer.Escape(name)
+		newProp, ok := new.Properties[name]
+		if !ok {
+			d.widened(propLocation, dir, "the property was removed")
+			continue
+		}
+		if required(new, name) && !required(old, name) {
+			d.narrowed(propLocation, dir, "the property became required")
+		} else if required(old, name) && !required(new, name) {
+			d.widened(propLocation, dir, "the property became optional")
+		}
+		oldProp := old.Properties[name]
+		d.schema(propLocation, dir, &oldProp, &newProp)
+	}
+	for _, name := range sortedKeys(new.Properties) {
+		if _, ok := old.Properties[name]; ok {
+			continue
+		}
+		propLocation := location + "/properties/" + jsonpointer.Escape(name)
+		if required(new, name) {
+			d.narrowed(propLocation, dir, "a required property was added")
+		} else {
+			d.compatible(propLocation, "an optional property was added")
+		}
+	}
+
+	for i := range old.AllOf {
+		allOfLocation := fmt.Sprintf("%s/allOf/%d", location, i)
+		if i >= len(new.AllOf) {
+			d.widened(allOfLocation, dir, "the allOf schema was removed")
+			continue
+		}
+		d.schema(allOfLocation, dir, &old.AllOf[i], &new.AllOf[i])
+	}
+	for i := len(old.AllOf); i < len(new.AllOf); i++ {
+		d.narrowed(fmt.Sprintf("%s/allOf/%d", location, i), dir, "an allOf schema was added")
+	}
+
+	d.additionalProperties(location+"/additionalProperties", dir, old.AdditionalProperties, new.AdditionalProperties)
+
+	var oldItems, newItems *spec.Schema
+	if old.Items != nil {
+		oldItems = old.Items.Schema
+	}
+	if new.Items != nil {
+		newItems = new.Items.Schema
+	}
+	d.schema(location+"/items", dir, oldItems, newItems)
+}
+
+// additionalProperties compares what additional properties are allowed,
+// when the additional properties aren't declared any are allowed
+func (d *differ) additionalProperties(location string, dir direction, old, new *spec.SchemaOrBool) {
+	allows := func(sob *spec.SchemaOrBool) (bool, *spec.Schema) {
+		if sob == nil {
+			return true, nil
+		}
+		return sob.Allows || sob.Schema != nil, sob.Schema
+	}
+	oldAllows, oldSchema := allows(old)
+	newAllows, newSchema := allows(new)
+	switch {
+	case oldAllows && !newAllows:
+		d.narrowed(location, dir, "additional properties are no longer allowed")
+	case !oldAllows && newAllows:
+		d.widened(location, dir, "additional properties are allowed")
+	case oldSchema == nil && newSchema != nil:
+		d.narrowed(location, dir, "the additional properties were restricted to a schema")
+	case oldSchema != nil && newSchema == nil:
+		d.widened(location, dir, "the additional properties are no longer restricted to a schema")
+	case oldSchema != nil:
+		d.schema(location, dir, oldSchema, newSchema)
+	}
+}
+
+func (d *differ) simpleType(location, oldType, oldFormat, newType, newFormat string) {
+	if oldType != newType {
+		d.breaking(location, "the type changed from %q to %q", oldType, newType)
+	} else if oldFormat != newFormat {
+		d.breaking(location, "the format changed from %q to %q", oldFormat, newFormat)
+	}
+}
+
+func (d *differ) enum(location string, dir direction, old, new []interface{}) {
+	contains := func(values []interface{}, value interface{}) bool {
+		for _, v := range values {
+			if reflect.DeepEqual(v, value) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(new) > 0 {
+		var removed []string
+		for _, v := range old {
+			if !contains(new, v) {
+				removed = append(removed, fmt.Sprint(v))
+			}
+		}
+		if len(old) == 0 {
+			d.narrowed(location, dir, "the values were restricted to an enum")
+		} else if len(removed) > 0 {
+			d.narrowed(location, dir, "the enum no longer allows %s", strings.Join(removed, ", "))
+		}
+	}
+	if len(old) > 0 {
+		var added []string
+		for _, v := range new {
+			if !contains(old, v) {
+				added = append(added, fmt.Sprint(v))
+			}
+		}
+		if len(new) == 0 {
+			d.widened(location, dir, "the enum was removed")
+		} else if len(added) > 0 {
+			d.widened(location, dir, "the enum also allows %s", strings.Join(added, ", "))
+		}
+	}
+}
+
+func sortedKeys(data interface{}) []string {
+	val := reflect.ValueOf(data)
+	var keys []string
+	for _, k := range val.MapKeys() {
+		keys = append(keys, k.String())
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,83 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/casualjim/go-swagger/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+func loadVersions(t testing.TB) (*spec.Document, *spec.Document) {
+	return load(t, "v1"), load(t, "v2")
+}
+
+func load(t testing.TB, name string) *spec.Document {
+	doc, err := spec.Load("../fixtures/diff/" + name + ".yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+func lines(changes Changes) []string {
+	var result []string
+	for _, change := range changes {
+		result = append(result, change.String())
+	}
+	return result
+}
+
+func TestCompare(t *testing.T) {
+	v1, v2 := loadVersions(t)
+	changes := Compare(v1, v2)
+	assert.True(t, changes.Breaking())
+	assert.Equal(t, []string{
+		`breaking /paths/~1pets~1{id}/delete: the operation was removed`,
+		`breaking /paths/~1pets/get/parameters/0: the format changed from "int32" to "int64"`,
+		`breaking /paths/~1pets/get/parameters/1: the enum no longer allows pending`,
+		`compatible /paths/~1pets/get/parameters/1: the enum also allows adopted`,
+		`breaking /paths/~1pets/get/parameters/2: a required parameter was added`,
+		`compatible /paths/~1pets/get/parameters/3: an optional parameter was added`,
+		`breaking /paths/~1pets~1{id}/get/parameters/0: the type changed from "integer" to "string"`,
+		`breaking /paths/~1pets~1{id}/get/responses/404: the response was removed`,
+		`compatible /paths/~1pets/post: the operation was added`,
+		`breaking /definitions/error: the definition was removed`,
+		`breaking /definitions/pet/properties/age: the property became required`,
+		`breaking /definitions/pet/properties/nickname: the property was removed`,
+		`compatible /definitions/pet/properties/color: an optional property was added`,
+		`compatible /definitions/tag: the definition was added`,
+	}, lines(changes))
+}
+
+func TestCompareSameVersion(t *testing.T) {
+	v1, _ := loadVersions(t)
+	changes := Compare(v1, v1)
+	assert.Empty(t, changes)
+	assert.False(t, changes.Breaking())
+}
+
+func TestCompare_Directions(t *testing.T) {
+	changes := Compare(load(t, "directions-v1"), load(t, "directions-v2"))
+	assert.Equal(t, []string{
+		`breaking /paths/~1orders/post/responses/201/headers/Location: the header was removed`,
+		`breaking /paths/~1orders/post/responses/201/headers/X-Rate-Limit: the format changed from "int32" to "int64"`,
+		`breaking /paths/~1orders/post/responses/201/headers/X-Status: the enum also allows rejected`,
+		`compatible /paths/~1orders/post/responses/201/headers/X-Request-ID: the header was added`,
+		`breaking /definitions/newOrder/properties/labels/additionalProperties: additional properties are no longer allowed`,
+		`compatible /definitions/newOrder/properties/status: the enum also allows delivered`,
+		`breaking /definitions/newOrder/allOf/1: an allOf schema was added`,
+		`breaking /definitions/order/properties/labels/additionalProperties: additional properties are allowed`,
+		`breaking /definitions/order/properties/status: the enum also allows delivered`,
+		`breaking /definitions/order/allOf/1: the allOf schema was removed`,
+	}, lines(changes))
+}
+
+func TestCompare_Shared(t *testing.T) {
+	changes := Compare(load(t, "shared-v1"), load(t, "shared-v2"))
+	assert.Equal(t, []string{
+		`breaking /paths/~1orders/get/parameters/0: the parameter became required`,
+		`compatible /paths/~1orders/get/responses/200/schema: a schema was added`,
+		`breaking /paths/~1orders/get/responses/default/schema/properties/message: the property became optional`,
+		`breaking /paths/~1orders/get/responses/default/headers/X-Error-Code: the header was removed`,
+	}, lines(changes))
+}
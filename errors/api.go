@@ -1,7 +1,6 @@
 package errors
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
@@ -63,14 +62,6 @@ func (m *MethodNotAllowedError) Code() int32 {
 	return m.code
 }
 
-func errorAsJSON(err Error) []byte {
-	b, _ := json.Marshal(struct {
-		Code    int32  `json:"code"`
-		Message string `json:"message"`
-	}{err.Code(), err.Error()})
-	return b
-}
-
 func flattenComposite(errs *CompositeError) *CompositeError {
 	var res []error
 	for _, er := range errs.Errors {
@@ -91,28 +82,12 @@ func MethodNotAllowed(requested string, allow []string) Error {
 	return &MethodNotAllowedError{code: http.StatusMethodNotAllowed, Allowed: allow, message: msg}
 }
 
-// ServeError the error handler interface implemenation
+// ServeError the error handler interface implemenation.
+// It renders a problem that lists every validation failure, with the producer
+// negotiated for the request when there is one and as application/problem+json otherwise.
 func ServeError(rw http.ResponseWriter, r *http.Request, err error) {
-	switch e := err.(type) {
-	case *CompositeError:
-		er := flattenComposite(e)
-		ServeError(rw, r, er.Errors[0])
-	case *MethodNotAllowedError:
-		rw.Header().Add("Allow", strings.Join(err.(*MethodNotAllowedError).Allowed, ","))
-		rw.WriteHeader(int(e.Code()))
-		if r == nil || r.Method != "HEAD" {
-			rw.Write(errorAsJSON(e))
-		}
-	case Error:
-		rw.WriteHeader(int(e.Code()))
-		if r == nil || r.Method != "HEAD" {
-			rw.Write(errorAsJSON(e))
-		}
-	default:
-		rw.WriteHeader(http.StatusInternalServerError)
-		if r == nil || r.Method != "HEAD" {
-			rw.Write(errorAsJSON(New(http.StatusInternalServerError, err.Error())))
-		}
+	if e, ok := err.(*MethodNotAllowedError); ok {
+		rw.Header().Add("Allow", strings.Join(e.Allowed, ","))
 	}
-
+	writeProblem(rw, r, ProblemFor(err))
 }
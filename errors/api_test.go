@@ -1,7 +1,10 @@
 package errors
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -18,7 +21,7 @@ func TestServeError(t *testing.T) {
 	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
 	assert.Equal(t, "POST,PUT", recorder.Header().Get("Allow"))
 	// assert.Equal(t, "application/json", recorder.Header().Get("content-type"))
-	assert.Equal(t, `{"code":405,"message":"method GET is not allowed, but [POST,PUT] are"}`, recorder.Body.String())
+	assert.Equal(t, `{"type":"about:blank","title":"Method Not Allowed","status":405,"detail":"method GET is not allowed, but [POST,PUT] are","code":405,"message":"method GET is not allowed, but [POST,PUT] are"}`, recorder.Body.String())
 
 	// renders status code from error when present
 	err = NotFound("")
@@ -26,7 +29,7 @@ func TestServeError(t *testing.T) {
 	ServeError(recorder, nil, err)
 	assert.Equal(t, http.StatusNotFound, recorder.Code)
 	// assert.Equal(t, "application/json", recorder.Header().Get("content-type"))
-	assert.Equal(t, `{"code":404,"message":"Not found"}`, recorder.Body.String())
+	assert.Equal(t, `{"type":"about:blank","title":"Not Found","status":404,"detail":"Not found","code":404,"message":"Not found"}`, recorder.Body.String())
 
 	// defaults to internal server error
 	err = fmt.Errorf("some error")
@@ -34,7 +37,7 @@ func TestServeError(t *testing.T) {
 	ServeError(recorder, nil, err)
 	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
 	// assert.Equal(t, "application/json", recorder.Header().Get("content-type"))
-	assert.Equal(t, `{"code":500,"message":"some error"}`, recorder.Body.String())
+	assert.Equal(t, `{"type":"about:blank","title":"Internal Server Error","status":500,"detail":"some error","code":500,"message":"some error"}`, recorder.Body.String())
 }
 
 func TestAPIErrors(t *testing.T) {
@@ -73,3 +76,79 @@ func TestAPIErrors(t *testing.T) {
 	assert.EqualValues(t, http.StatusNotAcceptable, err.Code())
 	assert.EqualValues(t, "unsupported media type requested, only [application/json application/x-yaml] are available", err.Error())
 }
+
+func TestServeErrorListsAllFailures(t *testing.T) {
+	err := CompositeValidationError(
+		Required("name", "body"),
+		CompositeValidationError(InvalidType("id", "path", "integer", "abc")),
+	)
+	recorder := httptest.NewRecorder()
+	ServeError(recorder, nil, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	assert.Equal(t, ProblemMime, recorder.Header().Get("Content-Type"))
+
+	var problem Problem
+	if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &problem)) {
+		assert.Equal(t, ProblemType, problem.Type)
+		assert.Equal(t, "Unprocessable Entity", problem.Title)
+		assert.EqualValues(t, http.StatusUnprocessableEntity, problem.Status)
+		assert.Equal(t, "validation failure list", problem.Detail)
+		assert.EqualValues(t, http.StatusUnprocessableEntity, problem.Code)
+		assert.Equal(t, "validation failure list", problem.Message)
+		if assert.Len(t, problem.Errors, 2) {
+			assert.Equal(t, "name", problem.Errors[0].Name)
+			assert.Equal(t, "body", problem.Errors[0].In)
+			assert.Equal(t, "id", problem.Errors[1].Name)
+			assert.Equal(t, "path", problem.Errors[1].In)
+			assert.Equal(t, "abc", problem.Errors[1].Value)
+		}
+	}
+}
+
+func TestServeErrorWithProducer(t *testing.T) {
+	xmlProducer := producerFunc(func(w io.Writer, data interface{}) error {
+		return xml.NewEncoder(w).Encode(data)
+	})
+
+	request, _ := http.NewRequest("GET", "/pets", nil)
+	request = WithProducer(request, "application/xml", xmlProducer)
+	recorder := httptest.NewRecorder()
+	ServeError(recorder, request, NotFound(""))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Equal(t, "application/xml", recorder.Header().Get("Content-Type"))
+	assert.Equal(t, `<problem><type>about:blank</type><title>Not Found</title><status>404</status><detail>Not found</detail><instance>/pets</instance><code>404</code><message>Not found</message></problem>`, recorder.Body.String())
+
+	// json producers get the problem+json rendering
+	request, _ = http.NewRequest("GET", "/pets", nil)
+	request.Header.Set("Accept", ProblemMime)
+	request = WithProducer(request, "application/json", xmlProducer)
+	recorder = httptest.NewRecorder()
+	recorder.Header().Set("Content-Type", "application/json")
+	ServeError(recorder, request, NotFound(""))
+	assert.Equal(t, ProblemMime, recorder.Header().Get("Content-Type"))
+	assert.Equal(t, `{"type":"about:blank","title":"Not Found","status":404,"detail":"Not found","instance":"/pets","code":404,"message":"Not found"}`, recorder.Body.String())
+}
+
+func TestServeErrorProducerFails(t *testing.T) {
+	failing := producerFunc(func(w io.Writer, data interface{}) error {
+		return fmt.Errorf("can't render %T", data)
+	})
+
+	request, _ := http.NewRequest("GET", "/pets", nil)
+	request = WithProducer(request, "application/xml", failing)
+	recorder := httptest.NewRecorder()
+	ServeError(recorder, request, NotFound(""))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Equal(t, ProblemMime, recorder.Header().Get("Content-Type"))
+	var problem Problem
+	if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &problem)) {
+		assert.Equal(t, "Not found", problem.Detail)
+		assert.Equal(t, "/pets", problem.Instance)
+	}
+}
+
+type producerFunc func(io.Writer, interface{}) error
+
+func (f producerFunc) Produce(w io.Writer, data interface{}) error {
+	return f(w, data)
+}
@@ -0,0 +1,153 @@
+package errors
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ProblemMime the media type for error bodies rendered as json,
+// it's used when no other json media type was negotiated or when the client asks for it
+const ProblemMime = "application/problem+json"
+
+// ProblemType the type of the problems, the errors don't have a type of their own
+// so the title is the description of the status code
+const ProblemType = "about:blank"
+
+// Problem is the body that gets rendered for an error, in the RFC 7807 problem details format.
+// The code, message and errors are extension members, the code and message are there for
+// the clients of the earlier error bodies. For validation failures it lists every failure,
+// so a client learns about all of them at once.
+type Problem struct {
+	XMLName  xml.Name       `json:"-" xml:"problem"`
+	Type     string         `json:"type" xml:"type"`
+	Title    string         `json:"title" xml:"title"`
+	Status   int32          `json:"status" xml:"status"`
+	Detail   string         `json:"detail,omitempty" xml:"detail,omitempty"`
+	Instance string         `json:"instance,omitempty" xml:"instance,omitempty"`
+	Code     int32          `json:"code" xml:"code"`
+	Message  string         `json:"message" xml:"message"`
+	Errors   []ProblemError `json:"errors,omitempty" xml:"error,omitempty"`
+}
+
+// ProblemError describes a single failure in a problem
+type ProblemError struct {
+	Code    int32       `json:"code" xml:"code"`
+	Message string      `json:"message" xml:"message"`
+	Name    string      `json:"name,omitempty" xml:"name,omitempty"`
+	In      string      `json:"in,omitempty" xml:"in,omitempty"`
+	Value   interface{} `json:"value,omitempty" xml:"value,omitempty"`
+}
+
+// ProblemFor creates the problem to render for an error,
+// composite errors are flattened and every failure gets listed.
+func ProblemFor(err error) *Problem {
+	ce, ok := err.(*CompositeError)
+	if !ok {
+		pe := problemError(err)
+		return newProblem(pe.Code, pe.Message)
+	}
+
+	flat := flattenComposite(ce)
+	if len(flat.Errors) == 0 {
+		return newProblem(flat.Code(), flat.message)
+	}
+
+	var errs []ProblemError
+	for _, e := range flat.Errors {
+		errs = append(errs, problemError(e))
+	}
+	// the first failure determines the status code, like it always has
+	message := flat.message
+	if len(errs) == 1 {
+		message = errs[0].Message
+	}
+	problem := newProblem(errs[0].Code, message)
+	problem.Errors = errs
+	return problem
+}
+
+func newProblem(code int32, message string) *Problem {
+	return &Problem{
+		Type:    ProblemType,
+		Title:   http.StatusText(int(code)),
+		Status:  code,
+		Detail:  message,
+		Code:    code,
+		Message: message,
+	}
+}
+
+func problemError(err error) ProblemError {
+	switch e := err.(type) {
+	case *Validation:
+		return ProblemError{Code: e.Code(), Message: e.Error(), Name: e.Name, In: e.In, Value: e.Value}
+	case *ParseError:
+		return ProblemError{Code: e.Code(), Message: e.Error(), Name: e.Name, In: e.In, Value: e.Value}
+	case Error:
+		return ProblemError{Code: e.Code(), Message: e.Error()}
+	}
+	return ProblemError{Code: http.StatusInternalServerError, Message: err.Error()}
+}
+
+// Producer renders a value onto a writer, httpkit producers satisfy this interface
+type Producer interface {
+	Produce(io.Writer, interface{}) error
+}
+
+type producerKey struct{}
+
+type negotiatedProducer struct {
+	mediaType string
+	producer  Producer
+}
+
+// WithProducer returns a shallow copy of the request that makes ServeError render
+// the problem with the producer negotiated for the request.
+func WithProducer(r *http.Request, mediaType string, producer Producer) *http.Request {
+	if producer == nil {
+		return r
+	}
+	return r.WithContext(context.WithValue(r.Context(), producerKey{}, negotiatedProducer{mediaType, producer}))
+}
+
+func isJSON(mediaType string) bool {
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+func writeProblem(rw http.ResponseWriter, r *http.Request, problem *Problem) {
+	if r != nil && r.URL != nil {
+		problem.Instance = r.URL.Path
+	}
+
+	if r != nil {
+		if np, ok := r.Context().Value(producerKey{}).(negotiatedProducer); ok && !isJSON(np.mediaType) {
+			// the problem is rendered before the status is written, so a producer
+			// that can't render it falls back to the json rendering
+			var buf bytes.Buffer
+			if err := np.producer.Produce(&buf, problem); err == nil {
+				rw.Header().Set("Content-Type", np.mediaType)
+				rw.WriteHeader(int(problem.Code))
+				if r.Method != "HEAD" {
+					rw.Write(buf.Bytes())
+				}
+				return
+			}
+			rw.Header().Del("Content-Type")
+		}
+	}
+
+	// keep a json content type that was negotiated already, unless the client asks for problems
+	if rw.Header().Get("Content-Type") == "" || (r != nil && strings.Contains(r.Header.Get("Accept"), ProblemMime)) {
+		rw.Header().Set("Content-Type", ProblemMime)
+	}
+	rw.WriteHeader(int(problem.Code))
+	if r == nil || r.Method != "HEAD" {
+		b, _ := json.Marshal(problem)
+		rw.Write(b)
+	}
+}
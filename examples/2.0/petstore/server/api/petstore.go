@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -32,12 +33,12 @@ func NewPetstore() (http.Handler, error) {
 	return middleware.Serve(spec, api), nil
 }
 
-var getAllPets = httpkit.OperationHandlerFunc(func(data interface{}) (interface{}, error) {
+var getAllPets = httpkit.OperationHandlerFunc(func(ctx context.Context, data interface{}) (interface{}, error) {
 	fmt.Println("getAllPets")
 	pretty.Println(data)
 	return pets, nil
 })
-var createPet = httpkit.OperationHandlerFunc(func(data interface{}) (interface{}, error) {
+var createPet = httpkit.OperationHandlerFunc(func(ctx context.Context, data interface{}) (interface{}, error) {
 	fmt.Println("createPet")
 	pretty.Println(data)
 	body := data.(map[string]interface{})["pet"]
@@ -49,7 +50,7 @@ var createPet = httpkit.OperationHandlerFunc(func(data interface{}) (interface{}
 	return body, nil
 })
 
-var deletePet = httpkit.OperationHandlerFunc(func(data interface{}) (interface{}, error) {
+var deletePet = httpkit.OperationHandlerFunc(func(ctx context.Context, data interface{}) (interface{}, error) {
 	fmt.Println("deletePet")
 	pretty.Println(data)
 	id := data.(map[string]interface{})["id"].(int64)
@@ -57,7 +58,7 @@ var deletePet = httpkit.OperationHandlerFunc(func(data interface{}) (interface{}
 	return nil, nil
 })
 
-var getPetByID = httpkit.OperationHandlerFunc(func(data interface{}) (interface{}, error) {
+var getPetByID = httpkit.OperationHandlerFunc(func(ctx context.Context, data interface{}) (interface{}, error) {
 	fmt.Println("getPetByID")
 	pretty.Println(data)
 	id := data.(map[string]interface{})["id"].(int64)
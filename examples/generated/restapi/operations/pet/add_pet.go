@@ -4,6 +4,7 @@ package pet
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/casualjim/go-swagger/examples/generated/models"
@@ -11,15 +12,16 @@ import (
 )
 
 // AddPetHandlerFunc turns a function with the right signature into a add pet handler
-type AddPetHandlerFunc func(AddPetParams, *models.User) error
+type AddPetHandlerFunc func(context.Context, AddPetParams, *models.User) error
 
-func (fn AddPetHandlerFunc) Handle(params AddPetParams, principal *models.User) error {
-	return fn(params, principal)
+func (fn AddPetHandlerFunc) Handle(ctx context.Context, params AddPetParams, principal *models.User) error {
+	return fn(ctx, params, principal)
 }
 
-// AddPetHandler interface for that can handle valid add pet params
+// AddPetHandler interface for that can handle valid add pet params,
+// the context is cancelled when the client goes away or when the request has been handled
 type AddPetHandler interface {
-	Handle(AddPetParams, *models.User) error
+	Handle(context.Context, AddPetParams, *models.User) error
 }
 
 // NewAddPet creates a new http.Handler for the add pet operation
@@ -52,7 +54,7 @@ func (o *AddPet) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = o.Handler.Handle(o.Params, principal) // actually handle the request
+	err = o.Handler.Handle(r.Context(), o.Params, principal) // actually handle the request
 	if err != nil {
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
@@ -4,6 +4,7 @@ package pet
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/casualjim/go-swagger/examples/generated/models"
@@ -11,15 +12,16 @@ import (
 )
 
 // DeletePetHandlerFunc turns a function with the right signature into a delete pet handler
-type DeletePetHandlerFunc func(DeletePetParams, *models.User) error
+type DeletePetHandlerFunc func(context.Context, DeletePetParams, *models.User) error
 
-func (fn DeletePetHandlerFunc) Handle(params DeletePetParams, principal *models.User) error {
-	return fn(params, principal)
+func (fn DeletePetHandlerFunc) Handle(ctx context.Context, params DeletePetParams, principal *models.User) error {
+	return fn(ctx, params, principal)
 }
 
-// DeletePetHandler interface for that can handle valid delete pet params
+// DeletePetHandler interface for that can handle valid delete pet params,
+// the context is cancelled when the client goes away or when the request has been handled
 type DeletePetHandler interface {
-	Handle(DeletePetParams, *models.User) error
+	Handle(context.Context, DeletePetParams, *models.User) error
 }
 
 // NewDeletePet creates a new http.Handler for the delete pet operation
@@ -52,7 +54,7 @@ func (o *DeletePet) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = o.Handler.Handle(o.Params, principal) // actually handle the request
+	err = o.Handler.Handle(r.Context(), o.Params, principal) // actually handle the request
 	if err != nil {
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
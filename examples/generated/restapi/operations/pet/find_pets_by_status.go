@@ -4,6 +4,7 @@ package pet
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/casualjim/go-swagger/examples/generated/models"
@@ -11,15 +12,16 @@ import (
 )
 
 // FindPetsByStatusHandlerFunc turns a function with the right signature into a find pets by status handler
-type FindPetsByStatusHandlerFunc func(FindPetsByStatusParams, *models.User) ([]models.Pet, error)
+type FindPetsByStatusHandlerFunc func(context.Context, FindPetsByStatusParams, *models.User) ([]models.Pet, error)
 
-func (fn FindPetsByStatusHandlerFunc) Handle(params FindPetsByStatusParams, principal *models.User) ([]models.Pet, error) {
-	return fn(params, principal)
+func (fn FindPetsByStatusHandlerFunc) Handle(ctx context.Context, params FindPetsByStatusParams, principal *models.User) ([]models.Pet, error) {
+	return fn(ctx, params, principal)
 }
 
-// FindPetsByStatusHandler interface for that can handle valid find pets by status params
+// FindPetsByStatusHandler interface for that can handle valid find pets by status params,
+// the context is cancelled when the client goes away or when the request has been handled
 type FindPetsByStatusHandler interface {
-	Handle(FindPetsByStatusParams, *models.User) ([]models.Pet, error)
+	Handle(context.Context, FindPetsByStatusParams, *models.User) ([]models.Pet, error)
 }
 
 // NewFindPetsByStatus creates a new http.Handler for the find pets by status operation
@@ -52,7 +54,7 @@ func (o *FindPetsByStatus) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := o.Handler.Handle(o.Params, principal) // actually handle the request
+	res, err := o.Handler.Handle(r.Context(), o.Params, principal) // actually handle the request
 	if err != nil {
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
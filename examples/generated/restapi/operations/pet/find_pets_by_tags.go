@@ -4,6 +4,7 @@ package pet
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/casualjim/go-swagger/examples/generated/models"
@@ -11,15 +12,16 @@ import (
 )
 
 // FindPetsByTagsHandlerFunc turns a function with the right signature into a find pets by tags handler
-type FindPetsByTagsHandlerFunc func(FindPetsByTagsParams, *models.User) ([]models.Pet, error)
+type FindPetsByTagsHandlerFunc func(context.Context, FindPetsByTagsParams, *models.User) ([]models.Pet, error)
 
-func (fn FindPetsByTagsHandlerFunc) Handle(params FindPetsByTagsParams, principal *models.User) ([]models.Pet, error) {
-	return fn(params, principal)
+func (fn FindPetsByTagsHandlerFunc) Handle(ctx context.Context, params FindPetsByTagsParams, principal *models.User) ([]models.Pet, error) {
+	return fn(ctx, params, principal)
 }
 
-// FindPetsByTagsHandler interface for that can handle valid find pets by tags params
+// FindPetsByTagsHandler interface for that can handle valid find pets by tags params,
+// the context is cancelled when the client goes away or when the request has been handled
 type FindPetsByTagsHandler interface {
-	Handle(FindPetsByTagsParams, *models.User) ([]models.Pet, error)
+	Handle(context.Context, FindPetsByTagsParams, *models.User) ([]models.Pet, error)
 }
 
 // NewFindPetsByTags creates a new http.Handler for the find pets by tags operation
@@ -52,7 +54,7 @@ func (o *FindPetsByTags) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := o.Handler.Handle(o.Params, principal) // actually handle the request
+	res, err := o.Handler.Handle(r.Context(), o.Params, principal) // actually handle the request
 	if err != nil {
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
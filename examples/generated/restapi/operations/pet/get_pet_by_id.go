@@ -4,6 +4,7 @@ package pet
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/casualjim/go-swagger/examples/generated/models"
@@ -11,15 +12,16 @@ import (
 )
 
 // GetPetByIDHandlerFunc turns a function with the right signature into a get pet by i d handler
-type GetPetByIDHandlerFunc func(GetPetByIDParams, *models.User) (*models.Pet, error)
+type GetPetByIDHandlerFunc func(context.Context, GetPetByIDParams, *models.User) (*models.Pet, error)
 
-func (fn GetPetByIDHandlerFunc) Handle(params GetPetByIDParams, principal *models.User) (*models.Pet, error) {
-	return fn(params, principal)
+func (fn GetPetByIDHandlerFunc) Handle(ctx context.Context, params GetPetByIDParams, principal *models.User) (*models.Pet, error) {
+	return fn(ctx, params, principal)
 }
 
-// GetPetByIDHandler interface for that can handle valid get pet by i d params
+// GetPetByIDHandler interface for that can handle valid get pet by i d params,
+// the context is cancelled when the client goes away or when the request has been handled
 type GetPetByIDHandler interface {
-	Handle(GetPetByIDParams, *models.User) (*models.Pet, error)
+	Handle(context.Context, GetPetByIDParams, *models.User) (*models.Pet, error)
 }
 
 // NewGetPetByID creates a new http.Handler for the get pet by i d operation
@@ -52,7 +54,7 @@ func (o *GetPetByID) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := o.Handler.Handle(o.Params, principal) // actually handle the request
+	res, err := o.Handler.Handle(r.Context(), o.Params, principal) // actually handle the request
 	if err != nil {
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
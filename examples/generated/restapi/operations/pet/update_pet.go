@@ -4,6 +4,7 @@ package pet
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/casualjim/go-swagger/examples/generated/models"
@@ -11,15 +12,16 @@ import (
 )
 
 // UpdatePetHandlerFunc turns a function with the right signature into a update pet handler
-type UpdatePetHandlerFunc func(UpdatePetParams, *models.User) error
+type UpdatePetHandlerFunc func(context.Context, UpdatePetParams, *models.User) error
 
-func (fn UpdatePetHandlerFunc) Handle(params UpdatePetParams, principal *models.User) error {
-	return fn(params, principal)
+func (fn UpdatePetHandlerFunc) Handle(ctx context.Context, params UpdatePetParams, principal *models.User) error {
+	return fn(ctx, params, principal)
 }
 
-// UpdatePetHandler interface for that can handle valid update pet params
+// UpdatePetHandler interface for that can handle valid update pet params,
+// the context is cancelled when the client goes away or when the request has been handled
 type UpdatePetHandler interface {
-	Handle(UpdatePetParams, *models.User) error
+	Handle(context.Context, UpdatePetParams, *models.User) error
 }
 
 // NewUpdatePet creates a new http.Handler for the update pet operation
@@ -52,7 +54,7 @@ func (o *UpdatePet) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = o.Handler.Handle(o.Params, principal) // actually handle the request
+	err = o.Handler.Handle(r.Context(), o.Params, principal) // actually handle the request
 	if err != nil {
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
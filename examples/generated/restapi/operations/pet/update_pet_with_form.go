@@ -4,6 +4,7 @@ package pet
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/casualjim/go-swagger/examples/generated/models"
@@ -11,15 +12,16 @@ import (
 )
 
 // UpdatePetWithFormHandlerFunc turns a function with the right signature into a update pet with form handler
-type UpdatePetWithFormHandlerFunc func(UpdatePetWithFormParams, *models.User) error
+type UpdatePetWithFormHandlerFunc func(context.Context, UpdatePetWithFormParams, *models.User) error
 
-func (fn UpdatePetWithFormHandlerFunc) Handle(params UpdatePetWithFormParams, principal *models.User) error {
-	return fn(params, principal)
+func (fn UpdatePetWithFormHandlerFunc) Handle(ctx context.Context, params UpdatePetWithFormParams, principal *models.User) error {
+	return fn(ctx, params, principal)
 }
 
-// UpdatePetWithFormHandler interface for that can handle valid update pet with form params
+// UpdatePetWithFormHandler interface for that can handle valid update pet with form params,
+// the context is cancelled when the client goes away or when the request has been handled
 type UpdatePetWithFormHandler interface {
-	Handle(UpdatePetWithFormParams, *models.User) error
+	Handle(context.Context, UpdatePetWithFormParams, *models.User) error
 }
 
 // NewUpdatePetWithForm creates a new http.Handler for the update pet with form operation
@@ -52,7 +54,7 @@ func (o *UpdatePetWithForm) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = o.Handler.Handle(o.Params, principal) // actually handle the request
+	err = o.Handler.Handle(r.Context(), o.Params, principal) // actually handle the request
 	if err != nil {
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
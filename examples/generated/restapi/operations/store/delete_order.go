@@ -4,21 +4,23 @@ package store
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/casualjim/go-swagger/httpkit/middleware"
 )
 
 // DeleteOrderHandlerFunc turns a function with the right signature into a delete order handler
-type DeleteOrderHandlerFunc func(DeleteOrderParams) error
+type DeleteOrderHandlerFunc func(context.Context, DeleteOrderParams) error
 
-func (fn DeleteOrderHandlerFunc) Handle(params DeleteOrderParams) error {
-	return fn(params)
+func (fn DeleteOrderHandlerFunc) Handle(ctx context.Context, params DeleteOrderParams) error {
+	return fn(ctx, params)
 }
 
-// DeleteOrderHandler interface for that can handle valid delete order params
+// DeleteOrderHandler interface for that can handle valid delete order params,
+// the context is cancelled when the client goes away or when the request has been handled
 type DeleteOrderHandler interface {
-	Handle(DeleteOrderParams) error
+	Handle(context.Context, DeleteOrderParams) error
 }
 
 // NewDeleteOrder creates a new http.Handler for the delete order operation
@@ -41,7 +43,7 @@ func (o *DeleteOrder) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := o.Handler.Handle(o.Params) // actually handle the request
+	err := o.Handler.Handle(r.Context(), o.Params) // actually handle the request
 	if err != nil {
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
@@ -4,6 +4,7 @@ package store
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/casualjim/go-swagger/examples/generated/models"
@@ -11,15 +12,16 @@ import (
 )
 
 // GetOrderByIDHandlerFunc turns a function with the right signature into a get order by i d handler
-type GetOrderByIDHandlerFunc func(GetOrderByIDParams) (*models.Order, error)
+type GetOrderByIDHandlerFunc func(context.Context, GetOrderByIDParams) (*models.Order, error)
 
-func (fn GetOrderByIDHandlerFunc) Handle(params GetOrderByIDParams) (*models.Order, error) {
-	return fn(params)
+func (fn GetOrderByIDHandlerFunc) Handle(ctx context.Context, params GetOrderByIDParams) (*models.Order, error) {
+	return fn(ctx, params)
 }
 
-// GetOrderByIDHandler interface for that can handle valid get order by i d params
+// GetOrderByIDHandler interface for that can handle valid get order by i d params,
+// the context is cancelled when the client goes away or when the request has been handled
 type GetOrderByIDHandler interface {
-	Handle(GetOrderByIDParams) (*models.Order, error)
+	Handle(context.Context, GetOrderByIDParams) (*models.Order, error)
 }
 
 // NewGetOrderByID creates a new http.Handler for the get order by i d operation
@@ -42,7 +44,7 @@ func (o *GetOrderByID) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := o.Handler.Handle(o.Params) // actually handle the request
+	res, err := o.Handler.Handle(r.Context(), o.Params) // actually handle the request
 	if err != nil {
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
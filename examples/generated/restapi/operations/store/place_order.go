@@ -4,6 +4,7 @@ package store
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/casualjim/go-swagger/examples/generated/models"
@@ -11,15 +12,16 @@ import (
 )
 
 // PlaceOrderHandlerFunc turns a function with the right signature into a place order handler
-type PlaceOrderHandlerFunc func(PlaceOrderParams) (*models.Order, error)
+type PlaceOrderHandlerFunc func(context.Context, PlaceOrderParams) (*models.Order, error)
 
-func (fn PlaceOrderHandlerFunc) Handle(params PlaceOrderParams) (*models.Order, error) {
-	return fn(params)
+func (fn PlaceOrderHandlerFunc) Handle(ctx context.Context, params PlaceOrderParams) (*models.Order, error) {
+	return fn(ctx, params)
 }
 
-// PlaceOrderHandler interface for that can handle valid place order params
+// PlaceOrderHandler interface for that can handle valid place order params,
+// the context is cancelled when the client goes away or when the request has been handled
 type PlaceOrderHandler interface {
-	Handle(PlaceOrderParams) (*models.Order, error)
+	Handle(context.Context, PlaceOrderParams) (*models.Order, error)
 }
 
 // NewPlaceOrder creates a new http.Handler for the place order operation
@@ -42,7 +44,7 @@ func (o *PlaceOrder) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := o.Handler.Handle(o.Params) // actually handle the request
+	res, err := o.Handler.Handle(r.Context(), o.Params) // actually handle the request
 	if err != nil {
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
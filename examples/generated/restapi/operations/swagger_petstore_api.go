@@ -13,6 +13,7 @@ import (
 	"github.com/casualjim/go-swagger/httpkit/security"
 	"github.com/casualjim/go-swagger/spec"
 	"github.com/casualjim/go-swagger/strfmt"
+	"github.com/casualjim/go-swagger/swag"
 
 	"github.com/casualjim/go-swagger/examples/generated/models"
 	"github.com/casualjim/go-swagger/examples/generated/restapi/operations/pet"
@@ -26,6 +27,8 @@ func NewSwaggerPetstoreAPI(spec *spec.Document) *SwaggerPetstoreAPI {
 		spec:            spec,
 		handlers:        make(map[string]http.Handler),
 		formats:         strfmt.Default,
+		Logger:          swag.DiscardLogger(),
+		MaxMemory:       httpkit.DefaultMaxMemory,
 		defaultConsumes: "application/json",
 		defaultProduces: "application/json",
 	}
@@ -114,6 +117,20 @@ type SwaggerPetstoreAPI struct {
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
 	ServeError func(http.ResponseWriter, *http.Request, error)
+
+	// Logger is used to log the requests handled by this API, the requests aren't logged by default.
+	// Setting it to nil silences the logging.
+	Logger swag.Logger
+
+	// MaxMemory is the amount of memory a multipart form may use,
+	// uploaded files that don't fit are stored on disk.
+	MaxMemory int64
+
+	// ErrorMapper maps the errors of the operations onto the error models declared in the spec,
+	// when it returns nil or the operation declares no model the error is served with ServeError.
+	ErrorMapper middleware.ErrorMapper
+
+	errorMappers map[string]middleware.ErrorMapper
 }
 
 // SetDefaultProduces sets the default produces media type
@@ -249,6 +266,14 @@ func (s *SwaggerPetstoreAPI) Validate() error {
 	return nil
 }
 
+// RegisterErrorMapper registers an error mapper for a single operation, it takes precedence over ErrorMapper
+func (s *SwaggerPetstoreAPI) RegisterErrorMapper(operationID string, mapper middleware.ErrorMapper) {
+	if s.errorMappers == nil {
+		s.errorMappers = make(map[string]middleware.ErrorMapper)
+	}
+	s.errorMappers[operationID] = mapper
+}
+
 // ServeErrorFor gets a error handler for a given operation id
 func (s *SwaggerPetstoreAPI) ServeErrorFor(operationID string) func(http.ResponseWriter, *http.Request, error) {
 	return s.ServeError
@@ -322,6 +347,12 @@ func (s *SwaggerPetstoreAPI) initHandlerCache() {
 	if s.context == nil {
 		s.context = middleware.NewRoutableContext(s.spec, s, nil)
 	}
+	s.context.SetLogger(s.Logger)
+	s.context.SetMaxMemory(s.MaxMemory)
+	s.context.SetErrorMapper(s.ErrorMapper)
+	for operationID, mapper := range s.errorMappers {
+		s.context.SetErrorMapperFor(operationID, mapper)
+	}
 
 	s.handlers = make(map[string]http.Handler)
 
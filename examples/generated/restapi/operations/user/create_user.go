@@ -4,21 +4,23 @@ package user
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/casualjim/go-swagger/httpkit/middleware"
 )
 
 // CreateUserHandlerFunc turns a function with the right signature into a create user handler
-type CreateUserHandlerFunc func(CreateUserParams) error
+type CreateUserHandlerFunc func(context.Context, CreateUserParams) error
 
-func (fn CreateUserHandlerFunc) Handle(params CreateUserParams) error {
-	return fn(params)
+func (fn CreateUserHandlerFunc) Handle(ctx context.Context, params CreateUserParams) error {
+	return fn(ctx, params)
 }
 
-// CreateUserHandler interface for that can handle valid create user params
+// CreateUserHandler interface for that can handle valid create user params,
+// the context is cancelled when the client goes away or when the request has been handled
 type CreateUserHandler interface {
-	Handle(CreateUserParams) error
+	Handle(context.Context, CreateUserParams) error
 }
 
 // NewCreateUser creates a new http.Handler for the create user operation
@@ -41,7 +43,7 @@ func (o *CreateUser) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := o.Handler.Handle(o.Params) // actually handle the request
+	err := o.Handler.Handle(r.Context(), o.Params) // actually handle the request
 	if err != nil {
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
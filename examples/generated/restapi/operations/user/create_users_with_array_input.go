@@ -4,21 +4,23 @@ package user
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/casualjim/go-swagger/httpkit/middleware"
 )
 
 // CreateUsersWithArrayInputHandlerFunc turns a function with the right signature into a create users with array input handler
-type CreateUsersWithArrayInputHandlerFunc func(CreateUsersWithArrayInputParams) error
+type CreateUsersWithArrayInputHandlerFunc func(context.Context, CreateUsersWithArrayInputParams) error
 
-func (fn CreateUsersWithArrayInputHandlerFunc) Handle(params CreateUsersWithArrayInputParams) error {
-	return fn(params)
+func (fn CreateUsersWithArrayInputHandlerFunc) Handle(ctx context.Context, params CreateUsersWithArrayInputParams) error {
+	return fn(ctx, params)
 }
 
-// CreateUsersWithArrayInputHandler interface for that can handle valid create users with array input params
+// CreateUsersWithArrayInputHandler interface for that can handle valid create users with array input params,
+// the context is cancelled when the client goes away or when the request has been handled
 type CreateUsersWithArrayInputHandler interface {
-	Handle(CreateUsersWithArrayInputParams) error
+	Handle(context.Context, CreateUsersWithArrayInputParams) error
 }
 
 // NewCreateUsersWithArrayInput creates a new http.Handler for the create users with array input operation
@@ -41,7 +43,7 @@ func (o *CreateUsersWithArrayInput) ServeHTTP(rw http.ResponseWriter, r *http.Re
 		return
 	}
 
-	err := o.Handler.Handle(o.Params) // actually handle the request
+	err := o.Handler.Handle(r.Context(), o.Params) // actually handle the request
 	if err != nil {
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
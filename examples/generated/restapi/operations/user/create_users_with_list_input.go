@@ -4,21 +4,23 @@ package user
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/casualjim/go-swagger/httpkit/middleware"
 )
 
 // CreateUsersWithListInputHandlerFunc turns a function with the right signature into a create users with list input handler
-type CreateUsersWithListInputHandlerFunc func(CreateUsersWithListInputParams) error
+type CreateUsersWithListInputHandlerFunc func(context.Context, CreateUsersWithListInputParams) error
 
-func (fn CreateUsersWithListInputHandlerFunc) Handle(params CreateUsersWithListInputParams) error {
-	return fn(params)
+func (fn CreateUsersWithListInputHandlerFunc) Handle(ctx context.Context, params CreateUsersWithListInputParams) error {
+	return fn(ctx, params)
 }
 
-// CreateUsersWithListInputHandler interface for that can handle valid create users with list input params
+// CreateUsersWithListInputHandler interface for that can handle valid create users with list input params,
+// the context is cancelled when the client goes away or when the request has been handled
 type CreateUsersWithListInputHandler interface {
-	Handle(CreateUsersWithListInputParams) error
+	Handle(context.Context, CreateUsersWithListInputParams) error
 }
 
 // NewCreateUsersWithListInput creates a new http.Handler for the create users with list input operation
@@ -41,7 +43,7 @@ func (o *CreateUsersWithListInput) ServeHTTP(rw http.ResponseWriter, r *http.Req
 		return
 	}
 
-	err := o.Handler.Handle(o.Params) // actually handle the request
+	err := o.Handler.Handle(r.Context(), o.Params) // actually handle the request
 	if err != nil {
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
@@ -4,21 +4,23 @@ package user
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/casualjim/go-swagger/httpkit/middleware"
 )
 
 // DeleteUserHandlerFunc turns a function with the right signature into a delete user handler
-type DeleteUserHandlerFunc func(DeleteUserParams) error
+type DeleteUserHandlerFunc func(context.Context, DeleteUserParams) error
 
-func (fn DeleteUserHandlerFunc) Handle(params DeleteUserParams) error {
-	return fn(params)
+func (fn DeleteUserHandlerFunc) Handle(ctx context.Context, params DeleteUserParams) error {
+	return fn(ctx, params)
 }
 
-// DeleteUserHandler interface for that can handle valid delete user params
+// DeleteUserHandler interface for that can handle valid delete user params,
+// the context is cancelled when the client goes away or when the request has been handled
 type DeleteUserHandler interface {
-	Handle(DeleteUserParams) error
+	Handle(context.Context, DeleteUserParams) error
 }
 
 // NewDeleteUser creates a new http.Handler for the delete user operation
@@ -41,7 +43,7 @@ func (o *DeleteUser) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := o.Handler.Handle(o.Params) // actually handle the request
+	err := o.Handler.Handle(r.Context(), o.Params) // actually handle the request
 	if err != nil {
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
@@ -4,6 +4,7 @@ package user
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/casualjim/go-swagger/examples/generated/models"
@@ -11,15 +12,16 @@ import (
 )
 
 // GetUserByNameHandlerFunc turns a function with the right signature into a get user by name handler
-type GetUserByNameHandlerFunc func(GetUserByNameParams) (*models.User, error)
+type GetUserByNameHandlerFunc func(context.Context, GetUserByNameParams) (*models.User, error)
 
-func (fn GetUserByNameHandlerFunc) Handle(params GetUserByNameParams) (*models.User, error) {
-	return fn(params)
+func (fn GetUserByNameHandlerFunc) Handle(ctx context.Context, params GetUserByNameParams) (*models.User, error) {
+	return fn(ctx, params)
 }
 
-// GetUserByNameHandler interface for that can handle valid get user by name params
+// GetUserByNameHandler interface for that can handle valid get user by name params,
+// the context is cancelled when the client goes away or when the request has been handled
 type GetUserByNameHandler interface {
-	Handle(GetUserByNameParams) (*models.User, error)
+	Handle(context.Context, GetUserByNameParams) (*models.User, error)
 }
 
 // NewGetUserByName creates a new http.Handler for the get user by name operation
@@ -42,7 +44,7 @@ func (o *GetUserByName) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := o.Handler.Handle(o.Params) // actually handle the request
+	res, err := o.Handler.Handle(r.Context(), o.Params) // actually handle the request
 	if err != nil {
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
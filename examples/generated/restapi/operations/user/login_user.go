@@ -4,21 +4,23 @@ package user
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/casualjim/go-swagger/httpkit/middleware"
 )
 
 // LoginUserHandlerFunc turns a function with the right signature into a login user handler
-type LoginUserHandlerFunc func(LoginUserParams) (string, error)
+type LoginUserHandlerFunc func(context.Context, LoginUserParams) (string, error)
 
-func (fn LoginUserHandlerFunc) Handle(params LoginUserParams) (string, error) {
-	return fn(params)
+func (fn LoginUserHandlerFunc) Handle(ctx context.Context, params LoginUserParams) (string, error) {
+	return fn(ctx, params)
 }
 
-// LoginUserHandler interface for that can handle valid login user params
+// LoginUserHandler interface for that can handle valid login user params,
+// the context is cancelled when the client goes away or when the request has been handled
 type LoginUserHandler interface {
-	Handle(LoginUserParams) (string, error)
+	Handle(context.Context, LoginUserParams) (string, error)
 }
 
 // NewLoginUser creates a new http.Handler for the login user operation
@@ -41,7 +43,7 @@ func (o *LoginUser) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := o.Handler.Handle(o.Params) // actually handle the request
+	res, err := o.Handler.Handle(r.Context(), o.Params) // actually handle the request
 	if err != nil {
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
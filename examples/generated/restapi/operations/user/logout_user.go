@@ -4,21 +4,23 @@ package user
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/casualjim/go-swagger/httpkit/middleware"
 )
 
 // LogoutUserHandlerFunc turns a function with the right signature into a logout user handler
-type LogoutUserHandlerFunc func() error
+type LogoutUserHandlerFunc func(context.Context) error
 
-func (fn LogoutUserHandlerFunc) Handle() error {
-	return fn()
+func (fn LogoutUserHandlerFunc) Handle(ctx context.Context) error {
+	return fn(ctx)
 }
 
-// LogoutUserHandler interface for that can handle valid logout user params
+// LogoutUserHandler interface for that can handle valid logout user params,
+// the context is cancelled when the client goes away or when the request has been handled
 type LogoutUserHandler interface {
-	Handle() error
+	Handle(context.Context) error
 }
 
 // NewLogoutUser creates a new http.Handler for the logout user operation
@@ -40,7 +42,7 @@ func (o *LogoutUser) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := o.Handler.Handle() // actually handle the request
+	err := o.Handler.Handle(r.Context()) // actually handle the request
 	if err != nil {
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
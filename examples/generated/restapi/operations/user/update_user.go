@@ -4,21 +4,23 @@ package user
 // Editing this file might prove futile when you re-run the generate command
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/casualjim/go-swagger/httpkit/middleware"
 )
 
 // UpdateUserHandlerFunc turns a function with the right signature into a update user handler
-type UpdateUserHandlerFunc func(UpdateUserParams) error
+type UpdateUserHandlerFunc func(context.Context, UpdateUserParams) error
 
-func (fn UpdateUserHandlerFunc) Handle(params UpdateUserParams) error {
-	return fn(params)
+func (fn UpdateUserHandlerFunc) Handle(ctx context.Context, params UpdateUserParams) error {
+	return fn(ctx, params)
 }
 
-// UpdateUserHandler interface for that can handle valid update user params
+// UpdateUserHandler interface for that can handle valid update user params,
+// the context is cancelled when the client goes away or when the request has been handled
 type UpdateUserHandler interface {
-	Handle(UpdateUserParams) error
+	Handle(context.Context, UpdateUserParams) error
 }
 
 // NewUpdateUser creates a new http.Handler for the update user operation
@@ -41,7 +43,7 @@ func (o *UpdateUser) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err := o.Handler.Handle(o.Params) // actually handle the request
+	err := o.Handler.Handle(r.Context(), o.Params) // actually handle the request
 	if err != nil {
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
@@ -0,0 +1,546 @@
+// Package fake generates random data that is valid for the schemas and parameters of a swagger spec.
+//
+// The generated values honour the type, format, enum, pattern, bounds, sizes and required properties
+// of a schema and follow the refs to the definitions of the spec. The values are the types you get
+// when decoding json into an interface{}, except for integers which are int64, or int32 for the
+// int32 format.
+//
+// A generator is seeded, so the same seed generates the same data for the same schemas.
+package fake
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/casualjim/go-swagger/spec"
+	"github.com/casualjim/go-swagger/strfmt"
+)
+
+// FormatGenerator generates a random string for a string format
+type FormatGenerator func(*rand.Rand) string
+
+// Generator generates random data for schemas
+type Generator struct {
+	// MaxItems the most items an array gets when the schema doesn't limit it, defaults to 3
+	MaxItems int
+	// MaxDepth how deep the refs are followed, optional properties are left out below that depth, defaults to 5
+	MaxDepth int
+
+	lock       sync.Mutex
+	rng        *rand.Rand
+	spec       *spec.Swagger
+	formats    strfmt.Registry
+	generators map[string]FormatGenerator
+}
+
+// maxAttempts how often a value is generated again when it doesn't validate
+const maxAttempts = 50
+
+// New creates a generator for the schemas of the spec, the formats registry validates the generated
+// strings for a format, when it's nil the default registry is used.
+func New(sp *spec.Swagger, formats strfmt.Registry, seed int64) *Generator {
+	if sp == nil {
+		sp = new(spec.Swagger)
+	}
+	if formats == nil {
+		formats = strfmt.Default
+	}
+	generators := make(map[string]FormatGenerator, len(defaultFormats))
+	for k, v := range defaultFormats {
+		generators[k] = v
+	}
+	return &Generator{
+		MaxItems:   3,
+		MaxDepth:   5,
+		rng:        rand.New(rand.NewSource(seed)),
+		spec:       sp,
+		formats:    formats,
+		generators: generators,
+	}
+}
+
+// RegisterFormat registers the generator for a custom string format
+func (g *Generator) RegisterFormat(name string, generator FormatGenerator) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	g.generators[normalizeFormat(name)] = generator
+}
+
+// Schema generates a value for the schema
+func (g *Generator) Schema(schema *spec.Schema) (interface{}, error) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	return g.schema(schema, 0)
+}
+
+// Parameter generates a value for the parameter, for a body parameter it's a value for the schema
+// and for the other parameters it's a value for the type, an array for an array parameter.
+func (g *Generator) Parameter(param *spec.Parameter) (interface{}, error) {
+	if param.In == "body" {
+		return g.Schema(param.Schema)
+	}
+	if param.Type == "file" {
+		return nil, fmt.Errorf("can't generate a value for the file parameter %q", param.Name)
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	schema := simpleSchema(param.Type, param.Format, param.Items)
+	schema.Maximum, schema.ExclusiveMaximum = param.Maximum, param.ExclusiveMaximum
+	schema.Minimum, schema.ExclusiveMinimum = param.Minimum, param.ExclusiveMinimum
+	schema.MaxLength, schema.MinLength = param.MaxLength, param.MinLength
+	schema.Pattern = param.Pattern
+	schema.MaxItems, schema.MinItems, schema.UniqueItems = param.MaxItems, param.MinItems, param.UniqueItems
+	schema.MultipleOf = param.MultipleOf
+	schema.Enum = param.Enum
+	return g.schema(schema, 0)
+}
+
+func simpleSchema(tpe, format string, items *spec.Items) *spec.Schema {
+	schema := new(spec.Schema).Typed(tpe, format)
+	if items != nil {
+		item := simpleSchema(items.Type, items.Format, items.Items)
+		item.Maximum, item.ExclusiveMaximum = items.Maximum, items.ExclusiveMaximum
+		item.Minimum, item.ExclusiveMinimum = items.Minimum, items.ExclusiveMinimum
+		item.MaxLength, item.MinLength = items.MaxLength, items.MinLength
+		item.Pattern = items.Pattern
+		item.MaxItems, item.MinItems, item.UniqueItems = items.MaxItems, items.MinItems, items.UniqueItems
+		item.MultipleOf = items.MultipleOf
+		item.Enum = items.Enum
+		schema.Items = &spec.SchemaOrArray{Schema: item}
+	}
+	return schema
+}
+
+func (g *Generator) resolve(schema *spec.Schema) (*spec.Schema, error) {
+	ref := schema.Ref.String()
+	if !strings.HasPrefix(ref, "#/definitions/") {
+		return nil, fmt.Errorf("can't resolve the ref %q", ref)
+	}
+	resolved, ok := g.spec.Definitions[strings.TrimPrefix(ref, "#/definitions/")]
+	if !ok {
+		return nil, fmt.Errorf("can't resolve the ref %q", ref)
+	}
+	return &resolved, nil
+}
+
+func (g *Generator) schema(schema *spec.Schema, depth int) (interface{}, error) {
+	if schema == nil {
+		return nil, nil
+	}
+	if schema.Ref.String() != "" {
+		if depth > g.MaxDepth {
+			return nil, fmt.Errorf("the schema at %q is nested too deep", schema.Ref.String())
+		}
+		resolved, err := g.resolve(schema)
+		if err != nil {
+			return nil, err
+		}
+		return g.schema(resolved, depth+1)
+	}
+
+	if len(schema.Enum) > 0 {
+		return schema.Enum[g.rng.Intn(len(schema.Enum))], nil
+	}
+	if len(schema.AllOf) > 0 {
+		merged, err := g.mergeAllOf(schema)
+		if err != nil {
+			return nil, err
+		}
+		return g.object(merged, depth)
+	}
+
+	switch g.typeOf(schema) {
+	case "object":
+		return g.object(schema, depth)
+	case "array":
+		return g.array(schema, depth)
+	case "string":
+		return g.string(schema)
+	case "integer":
+		return g.integer(schema)
+	case "number":
+		return g.number(schema)
+	case "boolean":
+		return g.rng.Intn(2) == 0, nil
+	case "null":
+		return nil, nil
+	case "file":
+		return nil, fmt.Errorf("can't generate a value for a file")
+	}
+	return nil, fmt.Errorf("can't generate a value for the type %q", strings.Join(schema.Type, ","))
+}
+
+// typeOf picks the type for the value, the int formats of a number make it an integer
+func (g *Generator) typeOf(schema *spec.Schema) string {
+	var tpe string
+	switch {
+	case len(schema.Type) == 1:
+		tpe = schema.Type[0]
+	case len(schema.Type) > 1:
+		tpe = schema.Type[g.rng.Intn(len(schema.Type))]
+	case len(schema.Properties) > 0 || schema.AdditionalProperties != nil:
+		tpe = "object"
+	case schema.Items != nil:
+		tpe = "array"
+	default:
+		tpe = "string"
+	}
+	if tpe == "number" && (schema.Format == "int32" || schema.Format == "int64") {
+		return "integer"
+	}
+	return tpe
+}
+
+// mergeAllOf merges the properties of the schemas in all of into a single object schema
+func (g *Generator) mergeAllOf(schema *spec.Schema) (*spec.Schema, error) {
+	merged := new(spec.Schema).Typed("object", "")
+	merged.Required = append(merged.Required, schema.Required...)
+	for name, prop := range schema.Properties {
+		merged.SetProperty(name, prop)
+	}
+	for i := range schema.AllOf {
+		part := &schema.AllOf[i]
+		if part.Ref.String() != "" {
+			resolved, err := g.resolve(part)
+			if err != nil {
+				return nil, err
+			}
+			part = resolved
+		}
+		if len(part.AllOf) > 0 {
+			m, err := g.mergeAllOf(part)
+			if err != nil {
+				return nil, err
+			}
+			part = m
+		}
+		merged.Required = append(merged.Required, part.Required...)
+		for name, prop := range part.Properties {
+			merged.SetProperty(name, prop)
+		}
+	}
+	return merged, nil
+}
+
+func (g *Generator) object(schema *spec.Schema, depth int) (interface{}, error) {
+	required := make(map[string]bool, len(schema.Required))
+	for _, name := range schema.Required {
+		required[name] = true
+	}
+	var names []string
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	maxProps := math.MaxInt32
+	if schema.MaxProperties != nil {
+		maxProps = int(*schema.MaxProperties)
+	}
+	minProps := 0
+	if schema.MinProperties != nil {
+		minProps = int(*schema.MinProperties)
+	}
+	if len(required) > maxProps {
+		return nil, fmt.Errorf("the object requires %d properties but allows only %d", len(required), maxProps)
+	}
+
+	result := make(map[string]interface{})
+	var optional []string
+	for _, name := range names {
+		if !required[name] {
+			optional = append(optional, name)
+			continue
+		}
+		prop := schema.Properties[name]
+		value, err := g.schema(&prop, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		result[name] = value
+	}
+
+	// the optional properties are left out at random, or when the object is nested deep
+	for _, name := range optional {
+		if len(result) >= maxProps || depth >= g.MaxDepth {
+			break
+		}
+		if len(result) >= minProps && g.rng.Intn(2) == 0 {
+			continue
+		}
+		prop := schema.Properties[name]
+		value, err := g.schema(&prop, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		result[name] = value
+	}
+
+	additional := schema.AdditionalProperties
+	if additional != nil && (additional.Allows || additional.Schema != nil) {
+		extra := 0
+		if len(schema.Properties) == 0 {
+			extra = g.rng.Intn(g.MaxItems + 1)
+		}
+		for i := 0; len(result) < maxProps && (i < extra || len(result) < minProps); i++ {
+			var value interface{} = g.randomWord(1, 10)
+			if additional.Schema != nil {
+				v, err := g.schema(additional.Schema, depth+1)
+				if err != nil {
+					return nil, err
+				}
+				value = v
+			}
+			result["additionalProp"+strconv.Itoa(i+1)] = value
+		}
+	}
+
+	if len(result) < minProps {
+		return nil, fmt.Errorf("the object needs %d properties but has only %d", minProps, len(result))
+	}
+	return result, nil
+}
+
+func (g *Generator) array(schema *spec.Schema, depth int) (interface{}, error) {
+	minItems := 0
+	if schema.MinItems != nil {
+		minItems = int(*schema.MinItems)
+	}
+	maxItems := minItems + g.MaxItems
+	if schema.MaxItems != nil {
+		maxItems = int(*schema.MaxItems)
+	}
+	if maxItems < minItems {
+		return nil, fmt.Errorf("the array needs at least %d items but allows only %d", minItems, maxItems)
+	}
+	size := minItems + g.rng.Intn(maxItems-minItems+1)
+	if size > 0 && depth >= g.MaxDepth {
+		size = minItems
+	}
+
+	var items *spec.Schema
+	if schema.Items != nil {
+		items = schema.Items.Schema
+	}
+	result := make([]interface{}, 0, size)
+	for attempt := 0; len(result) < size; attempt++ {
+		if attempt > size*maxAttempts {
+			return nil, fmt.Errorf("can't generate %d unique items", size)
+		}
+		var value interface{} = g.randomWord(1, 10)
+		if items != nil {
+			v, err := g.schema(items, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			value = v
+		}
+		if schema.UniqueItems && contains(result, value) {
+			continue
+		}
+		result = append(result, value)
+	}
+	return result, nil
+}
+
+func contains(values []interface{}, value interface{}) bool {
+	for _, v := range values {
+		if reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Generator) string(schema *spec.Schema) (interface{}, error) {
+	minLength, maxLength := int64(0), int64(-1)
+	if schema.MinLength != nil {
+		minLength = *schema.MinLength
+	}
+	if schema.MaxLength != nil {
+		maxLength = *schema.MaxLength
+	}
+	if maxLength >= 0 && maxLength < minLength {
+		return nil, fmt.Errorf("the string needs at least %d characters but allows only %d", minLength, maxLength)
+	}
+
+	var generate func() (string, error)
+	switch {
+	case schema.Format != "":
+		format := normalizeFormat(schema.Format)
+		generator, ok := g.generators[format]
+		if !ok {
+			if g.formats.ContainsName(schema.Format) {
+				return nil, fmt.Errorf("there is no generator for the format %q", schema.Format)
+			}
+			// unknown formats are just strings
+			generator = func(*rand.Rand) string { return g.randomWord(int(minLength), int(maxLength)) }
+		}
+		generate = func() (string, error) {
+			value := generator(g.rng)
+			if g.formats.ContainsName(schema.Format) && !g.formats.Validates(schema.Format, value) {
+				return "", fmt.Errorf("the generated value %q isn't a valid %s", value, schema.Format)
+			}
+			return value, nil
+		}
+	case schema.Pattern != "":
+		pattern, err := newPatternGenerator(schema.Pattern)
+		if err != nil {
+			return nil, err
+		}
+		generate = func() (string, error) {
+			value := pattern.generate(g.rng)
+			if !pattern.matches(value) {
+				return "", fmt.Errorf("the generated value %q doesn't match the pattern %q", value, schema.Pattern)
+			}
+			return value, nil
+		}
+	default:
+		return g.randomWord(int(minLength), int(maxLength)), nil
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		value, err := generate()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		length := int64(len([]rune(value)))
+		if length < minLength || (maxLength >= 0 && length > maxLength) {
+			lastErr = fmt.Errorf("can't generate a string between %d and %d characters", minLength, maxLength)
+			continue
+		}
+		return value, nil
+	}
+	return nil, lastErr
+}
+
+const letters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// randomWord a word of letters, a negative max means there is no max
+func (g *Generator) randomWord(min, max int) string {
+	if max < 0 {
+		max = min + 10
+	}
+	size := min
+	if max > min {
+		size += g.rng.Intn(max - min + 1)
+	}
+	b := make([]byte, size)
+	for i := range b {
+		b[i] = letters[g.rng.Intn(len(letters))]
+	}
+	return string(b)
+}
+
+// bounds the lowest and highest value allowed by the schema, step is the smallest difference
+// between two values, it's used to stay away from exclusive bounds
+func bounds(schema *spec.Schema, step, lowest, highest float64) (float64, float64, error) {
+	min, max := lowest, highest
+	if schema.Minimum != nil {
+		min = *schema.Minimum
+		if schema.ExclusiveMinimum {
+			min += step
+		}
+	}
+	if schema.Maximum != nil {
+		max = *schema.Maximum
+		if schema.ExclusiveMaximum {
+			max -= step
+		}
+	}
+	switch {
+	case schema.Minimum != nil && schema.Maximum == nil && max < min:
+		max = min + highest
+	case schema.Maximum != nil && schema.Minimum == nil && min > max:
+		min = max - highest
+	}
+	if min > max {
+		return 0, 0, fmt.Errorf("there is no number between %v and %v", min, max)
+	}
+	return min, max, nil
+}
+
+func (g *Generator) integer(schema *spec.Schema) (interface{}, error) {
+	lowest, highest := 0.0, 1000.0
+	min, max, err := bounds(schema, 1, lowest, highest)
+	if err != nil {
+		return nil, err
+	}
+	min, max = math.Ceil(min), math.Floor(max)
+	if schema.Format == "int32" {
+		min, max = math.Max(min, math.MinInt32), math.Min(max, math.MaxInt32)
+	} else {
+		// the float64 right below 2^63, bigger values overflow an int64
+		min, max = math.Max(min, math.MinInt64), math.Min(max, math.Nextafter(math.MaxInt64, 0))
+	}
+
+	step := 1.0
+	if schema.MultipleOf != nil {
+		step = *schema.MultipleOf
+	}
+	first, last := math.Ceil(min/step), math.Floor(max/step)
+	if schema.MultipleOf != nil {
+		first, last = safeMultiples(first, last)
+	}
+	if first > last {
+		return nil, fmt.Errorf("there is no multiple of %v between %v and %v", step, min, max)
+	}
+	n, err := g.whole(first, last)
+	if err != nil {
+		return nil, err
+	}
+	value := int64(n * step)
+	if schema.Format == "int32" {
+		return int32(value), nil
+	}
+	return value, nil
+}
+
+func (g *Generator) number(schema *spec.Schema) (interface{}, error) {
+	min, max, err := bounds(schema, 0.001, 0, 1000)
+	if err != nil {
+		return nil, err
+	}
+	if schema.MultipleOf != nil {
+		step := *schema.MultipleOf
+		first, last := safeMultiples(math.Ceil(min/step), math.Floor(max/step))
+		if first > last {
+			return nil, fmt.Errorf("there is no multiple of %v between %v and %v", step, min, max)
+		}
+		n, err := g.whole(first, last)
+		if err != nil {
+			return nil, err
+		}
+		return n * step, nil
+	}
+	return min + g.rng.Float64()*(max-min), nil
+}
+
+// safeMultiples limits the multiples to the safe integers of javascript, the validators can't
+// tell whether a bigger quotient is a whole number
+func safeMultiples(first, last float64) (float64, float64) {
+	const safe = 1<<53 - 1
+	return math.Max(first, -safe), math.Min(last, safe)
+}
+
+// whole a random whole number between first and last, Int63n can't pick from more than 2^63
+// numbers so a wider range gets a uniform float rounded down to a whole number instead
+func (g *Generator) whole(first, last float64) (float64, error) {
+	span := last - first
+	if math.IsInf(span, 0) || math.IsNaN(span) {
+		return 0, fmt.Errorf("there are too many numbers between %v and %v to pick one", first, last)
+	}
+	if span < 1<<62 {
+		return first + float64(g.rng.Int63n(int64(span)+1)), nil
+	}
+	return math.Min(first+math.Floor(g.rng.Float64()*span), last), nil
+}
@@ -0,0 +1,206 @@
+package fake
+
+import (
+	"math/rand"
+	"regexp"
+	"testing"
+
+	"github.com/casualjim/go-swagger/spec"
+	"github.com/casualjim/go-swagger/strfmt"
+	"github.com/casualjim/go-swagger/validate"
+	"github.com/stretchr/testify/assert"
+)
+
+func petSpec() *spec.Swagger {
+	sp := new(spec.Swagger)
+	sp.Definitions = spec.Definitions{
+		"pet": *new(spec.Schema).
+			Typed("object", "").
+			WithRequired("id", "name", "tags").
+			SetProperty("id", *spec.Int64Property().WithMinimum(1, false)).
+			SetProperty("name", *spec.StringProperty().WithMinLength(3).WithMaxLength(10)).
+			SetProperty("status", *spec.StringProperty().WithEnum("available", "pending", "sold")).
+			SetProperty("tags", *spec.ArrayProperty(spec.RefProperty("#/definitions/tag")).WithMinItems(1)),
+		"tag": *new(spec.Schema).
+			Typed("object", "").
+			WithRequired("label").
+			SetProperty("label", *spec.StringProperty().WithPattern(`^[a-z]{2,5}-\d{3}$`)),
+	}
+	return sp
+}
+
+func TestSeedIsDeterministic(t *testing.T) {
+	sp := petSpec()
+	pet := spec.RefProperty("#/definitions/pet")
+
+	first, err := New(sp, nil, 42).Schema(pet)
+	if assert.NoError(t, err) {
+		second, err := New(sp, nil, 42).Schema(pet)
+		assert.NoError(t, err)
+		assert.Equal(t, first, second)
+	}
+}
+
+func TestRefsAndRequiredProperties(t *testing.T) {
+	sp := petSpec()
+	gen := New(sp, nil, 1)
+	label := regexp.MustCompile(`^[a-z]{2,5}-\d{3}$`)
+	for i := 0; i < 20; i++ {
+		value, err := gen.Schema(spec.RefProperty("#/definitions/pet"))
+		if !assert.NoError(t, err) {
+			return
+		}
+		pet := value.(map[string]interface{})
+		assert.True(t, pet["id"].(int64) >= 1)
+		name := pet["name"].(string)
+		assert.True(t, len(name) >= 3 && len(name) <= 10, name)
+		if status, ok := pet["status"]; ok {
+			assert.Contains(t, []interface{}{"available", "pending", "sold"}, status)
+		}
+		tags := pet["tags"].([]interface{})
+		assert.NotEmpty(t, tags)
+		for _, tag := range tags {
+			assert.Regexp(t, label, tag.(map[string]interface{})["label"])
+		}
+	}
+
+	_, err := gen.Schema(spec.RefProperty("#/definitions/owner"))
+	assert.Error(t, err)
+}
+
+func TestRecursiveRefs(t *testing.T) {
+	sp := new(spec.Swagger)
+	sp.Definitions = spec.Definitions{
+		"node": *new(spec.Schema).
+			Typed("object", "").
+			WithRequired("name").
+			SetProperty("name", *spec.StringProperty()).
+			SetProperty("children", *spec.ArrayProperty(spec.RefProperty("#/definitions/node"))),
+		"loop": *new(spec.Schema).
+			Typed("object", "").
+			WithRequired("next").
+			SetProperty("next", *spec.RefProperty("#/definitions/loop")),
+	}
+	gen := New(sp, nil, 3)
+
+	_, err := gen.Schema(spec.RefProperty("#/definitions/node"))
+	assert.NoError(t, err)
+	_, err = gen.Schema(spec.RefProperty("#/definitions/loop"))
+	assert.Error(t, err)
+}
+
+func TestFormats(t *testing.T) {
+	gen := New(nil, nil, 7)
+	formats := []string{
+		"date", "date-time", "uuid", "uuid3", "uuid4", "uuid5", "email", "uri", "hostname",
+		"ipv4", "ipv6", "byte", "password", "duration", "isbn", "isbn10", "isbn13",
+		"creditcard", "ssn", "hexcolor", "rgbcolor",
+	}
+	for _, format := range formats {
+		for i := 0; i < 20; i++ {
+			value, err := gen.Schema(spec.StrFmtProperty(format))
+			if assert.NoError(t, err, format) {
+				assert.True(t, strfmt.Default.Validates(format, value.(string)), "%s: %v", format, value)
+			}
+		}
+	}
+
+	gen.RegisterFormat("semver", func(r *rand.Rand) string { return "1.2.3" })
+	value, err := gen.Schema(spec.StrFmtProperty("semver"))
+	assert.NoError(t, err)
+	assert.Equal(t, "1.2.3", value)
+}
+
+func TestPatterns(t *testing.T) {
+	gen := New(nil, nil, 11)
+	patterns := []string{
+		`^[A-Z]{3}\d{4}$`,
+		`^(foo|bar)+baz?$`,
+		`^\w+@\w+\.(com|org)$`,
+		`^[^a-z\s]{5,8}$`,
+		`(?i)^hello$`,
+		`^\+?[0-9]{1,3}-[0-9]{3,}$`,
+	}
+	for _, pattern := range patterns {
+		re := regexp.MustCompile(pattern)
+		for i := 0; i < 20; i++ {
+			value, err := gen.Schema(spec.StringProperty().WithPattern(pattern))
+			if assert.NoError(t, err, pattern) {
+				assert.Regexp(t, re, value)
+			}
+		}
+	}
+}
+
+func TestBounds(t *testing.T) {
+	gen := New(nil, nil, 5)
+	schemas := []*spec.Schema{
+		new(spec.Schema).Typed("integer", "").WithMinimum(10, true).WithMaximum(12, true),
+		new(spec.Schema).Typed("integer", "int32").WithMaximum(-100, false),
+		new(spec.Schema).Typed("integer", "").WithMinimum(1, false).WithMaximum(100, false).WithMultipleOf(7),
+		new(spec.Schema).Typed("number", "").WithMinimum(0, true).WithMaximum(1, true),
+		new(spec.Schema).Typed("number", "").WithMinimum(0.5, false).WithMaximum(5, false).WithMultipleOf(0.5),
+		// ranges too wide to pick from with Int63n
+		new(spec.Schema).Typed("integer", "int64").WithMinimum(-9e18, false).WithMaximum(9e18, false),
+		new(spec.Schema).Typed("integer", "").WithMinimum(-1e30, false),
+		new(spec.Schema).Typed("number", "").WithMinimum(-1e19, false).WithMaximum(1e19, false).WithMultipleOf(0.5),
+		new(spec.Schema).Typed("number", "").WithMinimum(-1e300, false).WithMaximum(1e300, false).WithMultipleOf(1.0/1024),
+		spec.StringProperty().WithMinLength(20).WithMaxLength(25),
+		spec.ArrayProperty(spec.StringProperty().WithEnum("a", "b", "c", "d")).WithMinItems(2).WithMaxItems(4).UniqueValues(),
+		new(spec.Schema).Typed("object", "").WithMinProperties(2).WithMaxProperties(3).
+			SetProperty("a", *spec.StringProperty().WithMinLength(1)).
+			SetProperty("b", *spec.StringProperty().WithMinLength(1)).
+			SetProperty("c", *spec.StringProperty().WithMinLength(1)).
+			SetProperty("d", *spec.StringProperty().WithMinLength(1)),
+		spec.MapProperty(spec.Int32Property().WithMinimum(1, false)).WithMinProperties(1),
+	}
+	for _, schema := range schemas {
+		for i := 0; i < 20; i++ {
+			value, err := gen.Schema(schema)
+			if assert.NoError(t, err) {
+				assert.NoError(t, validate.AgainstSchema(schema, value, strfmt.Default), "%v", value)
+			}
+		}
+	}
+
+	impossible := []*spec.Schema{
+		new(spec.Schema).Typed("integer", "").WithMinimum(3, true).WithMaximum(4, true),
+		spec.StringProperty().WithMinLength(5).WithMaxLength(2),
+		spec.ArrayProperty(spec.BoolProperty()).WithMinItems(3).UniqueValues(),
+	}
+	for _, schema := range impossible {
+		_, err := gen.Schema(schema)
+		assert.Error(t, err)
+	}
+}
+
+func TestParameter(t *testing.T) {
+	gen := New(petSpec(), nil, 9)
+
+	limit := spec.QueryParam("limit").Typed("integer", "int32")
+	limit.Minimum, limit.Maximum = float64Ptr(1), float64Ptr(50)
+	value, err := gen.Parameter(limit)
+	if assert.NoError(t, err) {
+		assert.True(t, value.(int32) >= 1 && value.(int32) <= 50)
+	}
+
+	tags := spec.SimpleArrayParam("tags", "string", "uuid")
+	value, err = gen.Parameter(tags)
+	if assert.NoError(t, err) {
+		for _, tag := range value.([]interface{}) {
+			assert.True(t, strfmt.Default.Validates("uuid", tag.(string)))
+		}
+	}
+
+	value, err = gen.Parameter(spec.BodyParam("pet", spec.RefProperty("#/definitions/pet")))
+	if assert.NoError(t, err) {
+		assert.Contains(t, value, "name")
+	}
+
+	_, err = gen.Parameter(spec.FileParam("upload"))
+	assert.Error(t, err)
+}
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
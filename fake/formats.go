@@ -0,0 +1,184 @@
+package fake
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// normalizeFormat makes the names of formats match the way the strfmt registry matches them
+func normalizeFormat(name string) string {
+	return strings.ToLower(strings.Replace(name, "-", "", -1))
+}
+
+var defaultFormats = map[string]FormatGenerator{
+	"date":       fakeDate,
+	"datetime":   fakeDateTime,
+	"uuid":       func(r *rand.Rand) string { return fakeUUID(r, 4) },
+	"uuid3":      func(r *rand.Rand) string { return fakeUUID(r, 3) },
+	"uuid4":      func(r *rand.Rand) string { return fakeUUID(r, 4) },
+	"uuid5":      func(r *rand.Rand) string { return fakeUUID(r, 5) },
+	"email":      fakeEmail,
+	"uri":        fakeURI,
+	"hostname":   fakeHostname,
+	"ipv4":       fakeIPv4,
+	"ipv6":       fakeIPv6,
+	"byte":       fakeBase64,
+	"password":   fakePassword,
+	"duration":   fakeDuration,
+	"isbn":       fakeISBN10,
+	"isbn10":     fakeISBN10,
+	"isbn13":     fakeISBN13,
+	"creditcard": fakeCreditCard,
+	"ssn":        fakeSSN,
+	"hexcolor":   fakeHexColor,
+	"rgbcolor":   fakeRGBColor,
+}
+
+const lower = "abcdefghijklmnopqrstuvwxyz"
+
+func word(r *rand.Rand, min, max int) string {
+	b := make([]byte, min+r.Intn(max-min+1))
+	for i := range b {
+		b[i] = lower[r.Intn(len(lower))]
+	}
+	return string(b)
+}
+
+func digits(r *rand.Rand, n int) []int {
+	d := make([]int, n)
+	for i := range d {
+		d[i] = r.Intn(10)
+	}
+	return d
+}
+
+func joinDigits(d []int) string {
+	var s string
+	for _, v := range d {
+		s += strconv.Itoa(v)
+	}
+	return s
+}
+
+// randomTime a time between 1970 and 2038
+func randomTime(r *rand.Rand) time.Time {
+	return time.Unix(r.Int63n(1<<31), int64(r.Intn(1000))*int64(time.Millisecond)).UTC()
+}
+
+func fakeDate(r *rand.Rand) string {
+	return randomTime(r).Format("2006-01-02")
+}
+
+func fakeDateTime(r *rand.Rand) string {
+	return randomTime(r).Format("2006-01-02T15:04:05.000Z07:00")
+}
+
+func fakeUUID(r *rand.Rand, version int) string {
+	b := make([]byte, 16)
+	r.Read(b)
+	b[6] = (b[6] & 0x0f) | byte(version<<4)
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+var tlds = []string{"com", "org", "net", "io", "dev"}
+
+func fakeHostname(r *rand.Rand) string {
+	return word(r, 3, 10) + "." + tlds[r.Intn(len(tlds))]
+}
+
+func fakeEmail(r *rand.Rand) string {
+	return word(r, 3, 10) + "@" + fakeHostname(r)
+}
+
+func fakeURI(r *rand.Rand) string {
+	return "http://" + fakeHostname(r) + "/" + word(r, 3, 10)
+}
+
+func fakeIPv4(r *rand.Rand) string {
+	return fmt.Sprintf("%d.%d.%d.%d", 1+r.Intn(254), r.Intn(256), r.Intn(256), 1+r.Intn(254))
+}
+
+func fakeIPv6(r *rand.Rand) string {
+	parts := make([]string, 8)
+	for i := range parts {
+		parts[i] = strconv.FormatInt(int64(r.Intn(0x10000)), 16)
+	}
+	return strings.Join(parts, ":")
+}
+
+func fakeBase64(r *rand.Rand) string {
+	b := make([]byte, 4+r.Intn(16))
+	r.Read(b)
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+func fakePassword(r *rand.Rand) string {
+	return word(r, 4, 8) + strconv.Itoa(r.Intn(1000)) + strings.ToUpper(word(r, 2, 4))
+}
+
+var durationUnits = []string{"s", "m", "h"}
+
+func fakeDuration(r *rand.Rand) string {
+	return strconv.Itoa(1+r.Intn(59)) + durationUnits[r.Intn(len(durationUnits))]
+}
+
+func fakeISBN10(r *rand.Rand) string {
+	d := digits(r, 9)
+	sum := 0
+	for i, v := range d {
+		sum += (10 - i) * v
+	}
+	check := (11 - sum%11) % 11
+	if check == 10 {
+		return joinDigits(d) + "X"
+	}
+	return joinDigits(d) + strconv.Itoa(check)
+}
+
+func fakeISBN13(r *rand.Rand) string {
+	d := append([]int{9, 7, 8}, digits(r, 9)...)
+	sum := 0
+	for i, v := range d {
+		if i%2 == 0 {
+			sum += v
+		} else {
+			sum += 3 * v
+		}
+	}
+	return joinDigits(append(d, (10-sum%10)%10))
+}
+
+// fakeCreditCard a visa number with a valid luhn check digit
+func fakeCreditCard(r *rand.Rand) string {
+	d := append([]int{4}, digits(r, 14)...)
+	sum := 0
+	for i := len(d) - 1; i >= 0; i-- {
+		v := d[i]
+		// the check digit gets appended, so the doubling starts at the last digit
+		if (len(d)-1-i)%2 == 0 {
+			v *= 2
+			if v > 9 {
+				v -= 9
+			}
+		}
+		sum += v
+	}
+	return joinDigits(append(d, (10-sum%10)%10))
+}
+
+func fakeSSN(r *rand.Rand) string {
+	return fmt.Sprintf("%03d-%02d-%04d", 1+r.Intn(665), 1+r.Intn(99), 1+r.Intn(9999))
+}
+
+func fakeHexColor(r *rand.Rand) string {
+	return fmt.Sprintf("#%06x", r.Intn(0x1000000))
+}
+
+func fakeRGBColor(r *rand.Rand) string {
+	return fmt.Sprintf("rgb(%d,%d,%d)", r.Intn(256), r.Intn(256), r.Intn(256))
+}
@@ -0,0 +1,124 @@
+package fake
+
+import (
+	"math/rand"
+	"regexp"
+	"regexp/syntax"
+	"unicode"
+)
+
+// maxRepeat how often an unbounded repeat like * or + repeats at most
+const maxRepeat = 5
+
+// patternGenerator generates strings that match a regular expression
+type patternGenerator struct {
+	matcher *regexp.Regexp
+	tree    *syntax.Regexp
+}
+
+func newPatternGenerator(pattern string) (*patternGenerator, error) {
+	matcher, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil, err
+	}
+	return &patternGenerator{matcher: matcher, tree: tree.Simplify()}, nil
+}
+
+// generate a string for the pattern, a pattern that isn't anchored can have more text around the
+// generated part, but that is left out so the generated string is as short as possible
+func (p *patternGenerator) generate(r *rand.Rand) string {
+	var buf []rune
+	p.walk(r, p.tree, &buf)
+	return string(buf)
+}
+
+// matches checks the generated string, patterns with lookarounds or word boundaries don't always match
+func (p *patternGenerator) matches(value string) bool {
+	return p.matcher.MatchString(value)
+}
+
+func (p *patternGenerator) walk(r *rand.Rand, re *syntax.Regexp, buf *[]rune) {
+	switch re.Op {
+	case syntax.OpLiteral:
+		for _, c := range re.Rune {
+			if re.Flags&syntax.FoldCase != 0 && r.Intn(2) == 0 {
+				c = unicode.SimpleFold(c)
+			}
+			*buf = append(*buf, c)
+		}
+	case syntax.OpCharClass:
+		*buf = append(*buf, randomRune(r, re.Rune))
+	case syntax.OpAnyCharNotNL, syntax.OpAnyChar:
+		*buf = append(*buf, rune(letters[r.Intn(len(letters))]))
+	case syntax.OpCapture:
+		p.walk(r, re.Sub[0], buf)
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			p.walk(r, sub, buf)
+		}
+	case syntax.OpAlternate:
+		p.walk(r, re.Sub[r.Intn(len(re.Sub))], buf)
+	case syntax.OpStar:
+		p.repeat(r, re.Sub[0], 0, maxRepeat, buf)
+	case syntax.OpPlus:
+		p.repeat(r, re.Sub[0], 1, maxRepeat, buf)
+	case syntax.OpQuest:
+		p.repeat(r, re.Sub[0], 0, 1, buf)
+	case syntax.OpRepeat:
+		max := re.Max
+		if max < 0 {
+			max = re.Min + maxRepeat
+		}
+		p.repeat(r, re.Sub[0], re.Min, max, buf)
+	}
+	// the empty matches, anchors and boundaries don't add anything
+}
+
+func (p *patternGenerator) repeat(r *rand.Rand, re *syntax.Regexp, min, max int, buf *[]rune) {
+	count := min + r.Intn(max-min+1)
+	for i := 0; i < count; i++ {
+		p.walk(r, re, buf)
+	}
+}
+
+// randomRune picks a rune from the ranges of a char class, the printable ascii runes are
+// preferred so a negated class like [^a-z] doesn't produce control characters
+func randomRune(r *rand.Rand, ranges []rune) rune {
+	var printable []rune
+	for i := 0; i+1 < len(ranges); i += 2 {
+		lo, hi := ranges[i], ranges[i+1]
+		if lo < ' ' {
+			lo = ' '
+		}
+		if hi > '~' {
+			hi = '~'
+		}
+		if lo <= hi {
+			printable = append(printable, lo, hi)
+		}
+	}
+	if len(printable) > 0 {
+		ranges = printable
+	}
+	if len(ranges) == 0 {
+		return 'a'
+	}
+
+	var total int
+	for i := 0; i+1 < len(ranges); i += 2 {
+		total += int(ranges[i+1]-ranges[i]) + 1
+	}
+	n := r.Intn(total)
+	for i := 0; i+1 < len(ranges); i += 2 {
+		size := int(ranges[i+1]-ranges[i]) + 1
+		if n < size {
+			return ranges[i] + rune(n)
+		}
+		n -= size
+	}
+	return ranges[0]
+}
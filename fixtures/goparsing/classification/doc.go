@@ -25,6 +25,28 @@
 //     - application/json
 //     - application/xml
 //
+//     SecurityDefinitions:
+//       api_key:
+//         type: apiKey
+//         name: X-API-KEY
+//         in: header
+//       basic:
+//         type: basic
+//       oauth:
+//         type: oauth2
+//         flow: accessCode
+//         authorizationUrl: https://example.com/oauth/authorize
+//         tokenUrl: https://example.com/oauth/token
+//         scopes:
+//           read: read access to the pets
+//           write: write access to the pets
+//
+//     Extensions:
+//       x-meta-value: value
+//       x-meta-array:
+//         - value1
+//         - value2
+//
 //
 // swagger:meta
 package classification
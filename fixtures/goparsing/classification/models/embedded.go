@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	pets "github.com/casualjim/go-swagger/fixtures/goparsing/classification/transitive/mods"
+)
+
+// Tags is a named map of labels
+type Tags map[string]string
+
+// Pets is a named slice of pointers to pets
+type Pets []*pets.Pet
+
+// PetsByName is a named map of pets
+type PetsByName map[string]*pets.Pet
+
+// NotableThing is a named type for a struct in another package
+type NotableThing pets.Notable
+
+// An EmbeddedPointers model embeds structs by pointer and
+// refers to types declared in other packages.
+type EmbeddedPointers struct {
+	*Something
+	*NotableThing
+
+	// when a tag names the embedded struct it is a property of its own
+	pets.NotSelected `json:"selected"`
+
+	// the creation time
+	Stamp time.Time `json:"stamp"`
+
+	// the owner of this thing
+	Owner *pets.Pet `json:"owner"`
+
+	Friends  []*pets.Pet          `json:"friends"`
+	Siblings Pets                 `json:"siblings"`
+	ByName   map[string]*pets.Pet `json:"byName"`
+	Named    PetsByName           `json:"named"`
+	Labels   Tags                 `json:"labels"`
+	Matrix   [][]*pets.Category   `json:"matrix"`
+	Anything interface{}          `json:"anything"`
+}
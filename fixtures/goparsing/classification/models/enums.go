@@ -0,0 +1,54 @@
+package models
+
+// Status the status of a pet in the store
+//
+// swagger:enum
+type Status string
+
+const (
+	// StatusAvailable the pet can be bought
+	StatusAvailable Status = "available"
+	// StatusPending the pet is being bought
+	StatusPending Status = "pending"
+	// StatusSold the pet has found a new home
+	StatusSold Status = "sold"
+)
+
+// Priority the priority of an order
+//
+// swagger:enum
+type Priority int32
+
+const (
+	// PriorityLow the order can wait
+	PriorityLow Priority = iota + 1
+	// PriorityHigh the order is urgent
+	PriorityHigh
+)
+
+// EnumModel is a struct with enum properties
+type EnumModel struct {
+	// the status of the pet
+	Status Status `json:"status"`
+
+	// the priority of the order
+	Priority Priority `json:"priority"`
+
+	// the statuses the pet went through
+	Statuses []Status `json:"statuses"`
+
+	// the kind of pet
+	//
+	// Enum: dog, cat, bird
+	Kind string `json:"kind"`
+
+	// the size of the pet
+	//
+	// Enum: 1, 2, 3
+	Size int32 `json:"size"`
+
+	// the colors of the pet
+	//
+	// Items.Enum: red, green, blue
+	Colors []string `json:"colors"`
+}
@@ -0,0 +1,25 @@
+package models
+
+// An Extended model carries vendor extensions.
+//
+// swagger:model extended
+//
+// Extensions:
+//   x-go-type: Extended
+//   x-rate-limit:
+//     limit: 10
+//     period: 1m
+type Extended struct {
+	// the id of this model
+	//
+	// required: true
+	// Extensions:
+	//   x-order: 1
+	ID int64 `json:"id"`
+
+	// the owner of this model
+	//
+	// Extensions:
+	//   x-nullable: true
+	Owner *Something `json:"owner"`
+}
@@ -0,0 +1,38 @@
+package models
+
+// A Secret is an internal type that never shows up in the spec
+//
+// swagger:ignore
+type Secret struct {
+	Key string `json:"key"`
+}
+
+// A JSONTagged model uses the options of the json struct tags
+type JSONTagged struct {
+	// the id is encoded as a string
+	//
+	// required: true
+	ID int64 `json:"id,string"`
+
+	// a name is omitted when it's empty so it can't be required
+	//
+	// required: true
+	Name string `json:"name,omitempty"`
+
+	// required: true
+	Age int32 `json:"age"`
+
+	Active bool `json:",string"`
+
+	Internal string `json:"-"`
+
+	Dash string `json:"-,"`
+
+	// an attribute that is only used internally
+	//
+	// swagger:ignore
+	Hidden string `json:"hidden"`
+
+	Token  Secret    `json:"token"`
+	Tokens []*Secret `json:"tokens"`
+}
@@ -0,0 +1,45 @@
+package operations
+
+import "github.com/casualjim/go-swagger/fixtures/goparsing/classification/models"
+
+// EnumParams the parameters with enum types to test parsing
+//
+// swagger:parameters listOrders
+type EnumParams struct {
+	// the status of the pets
+	//
+	// in: query
+	Status models.Status `json:"status"`
+
+	// the statuses of the pets
+	//
+	// in: query
+	Statuses []models.Status `json:"statuses"`
+
+	// the priority of the orders
+	//
+	// in: header
+	Priority models.Priority `json:"X-Priority"`
+
+	// the kind of pet
+	//
+	// in: query
+	// enum: dog, cat, bird
+	Kind string `json:"kind"`
+}
+
+// EnumResponse the response with enum headers to test parsing
+//
+// swagger:response enumResponse
+type EnumResponse struct {
+	// the status of the pet
+	Status models.Status `json:"X-Status"`
+
+	// the priorities of the orders
+	Priorities []models.Priority `json:"X-Priorities"`
+
+	// the kind of pet
+	//
+	// enum: dog, cat, bird
+	Kind string `json:"X-Kind"`
+}
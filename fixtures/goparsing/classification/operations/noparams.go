@@ -49,6 +49,8 @@ type NoParams struct {
 	// maximum: 45
 	// multiple of: 3
 	// in: query
+	// Extensions:
+	//   x-example: 27
 	Score int32 `json:"score"`
 
 	// Name of this no model instance
@@ -7,6 +7,9 @@ import (
 
 // A GenericError is an error that is used when no other error is appropriate
 // swagger:response genericError
+//
+// Extensions:
+//   x-error-kind: generic
 type GenericError struct {
 	// The error message
 	// in: body
@@ -34,6 +34,12 @@ func ServeAPI(host, basePath string, schemes []string) error {
 	// default: genericError
 	// 200: someResponse
 	// 422: validationError
+	//
+	// Extensions:
+	//   x-example-flag: true
+	//   x-some-list:
+	//     - dog
+	//     - cat
 	mountItem("GET", basePath+"/pets", nil)
 
 	/* swagger:route POST /pets pets users createPet
@@ -0,0 +1,18 @@
+// Package framework is a stand in for a web framework like gin,
+// its handlers get a context with the request instead of the parameters.
+package framework
+
+import "net/http"
+
+// Param a parameter from the path of the request
+type Param struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Context the context of a request
+type Context struct {
+	Request *http.Request          `json:"request"`
+	Params  []Param                `json:"params"`
+	Keys    map[string]interface{} `json:"keys"`
+}
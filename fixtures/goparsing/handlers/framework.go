@@ -0,0 +1,16 @@
+package handlers
+
+import "github.com/casualjim/go-swagger/fixtures/goparsing/framework"
+
+// OrderQuery the parameters to filter the list of orders with
+type OrderQuery struct {
+	// the status of the orders to return
+	Status string `json:"status"`
+}
+
+// swagger:route GET /orders orders listOrders
+//
+// Lists the orders, with the context of a web framework.
+func ListOrders(c *framework.Context, params OrderQuery) error {
+	return nil
+}
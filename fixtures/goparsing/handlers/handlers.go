@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+)
+
+// A Pet is the main product in the store.
+//
+// swagger:model pet
+type Pet struct {
+	// The id of the pet.
+	//
+	// required: true
+	ID int64 `json:"id"`
+
+	// The name of the pet.
+	//
+	// required: true
+	Name string `json:"name"`
+}
+
+// PetQuery the parameters to filter the list of pets with
+type PetQuery struct {
+	// the maximum number of pets to return
+	//
+	// maximum: 100
+	Limit int32 `json:"limit"`
+
+	// the tags to filter the pets by
+	Tags []string `json:"tags"`
+}
+
+// PetID the parameters to look up a single pet
+type PetID struct {
+	// The id of the pet
+	//
+	// in: path
+	// required: true
+	ID int64 `json:"id"`
+}
+
+// PetBody the parameters to update a pet
+type PetBody struct {
+	PetID
+
+	// The pet to update
+	//
+	// in: body
+	// required: true
+	Pet *Pet `json:"pet"`
+}
+
+// A PetList is a page of pets
+//
+// swagger:response petList
+type PetList struct {
+	// The total amount of pets that match the query
+	XTotalCount int64 `json:"X-Total-Count"`
+
+	// in: body
+	Body []Pet `json:"body"`
+}
+
+// swagger:route GET /pets pets listPets
+//
+// Lists the pets that match the query.
+func ListPets(ctx context.Context, params PetQuery) (*PetList, error) {
+	return nil, nil
+}
+
+// swagger:route GET /pets/{id} pets getPet
+//
+// Gets a single pet.
+func GetPet(ctx context.Context, r *http.Request, params *PetID) (*Pet, error) {
+	return nil, nil
+}
+
+// swagger:route PUT /pets/{id} pets updatePet
+//
+// Updates a pet.
+//
+// Responses:
+// 200: petList
+func UpdatePet(ctx context.Context, params PetBody) (*Pet, error) {
+	return nil, nil
+}
+
+// swagger:route DELETE /pets/{id} pets deletePet
+//
+// Deletes a pet.
+func DeletePet(ctx context.Context, params PetID) error {
+	return nil
+}
@@ -0,0 +1,17 @@
+// +build ignore
+
+// the fixture is loaded by the vendor tests of the scanner, it isn't built with the rest of the tree
+
+package shop
+
+import (
+	"github.com/example/go-pets"
+)
+
+// Shop a shop with the pets from its own vendor folder, which is nearer than the one of the store
+//
+// swagger:model
+type Shop struct {
+	// the pet in the window
+	Pet pets.Pet `json:"pet"`
+}
@@ -0,0 +1,21 @@
+// +build ignore
+
+// the fixture is loaded by the vendor tests of the scanner, it isn't built with the rest of the tree
+
+package vendored
+
+import (
+	"github.com/example/go-pets"
+)
+
+// Store a store with the pets from a vendored package,
+// the name of that package isn't the last element of its import path
+//
+// swagger:model
+type Store struct {
+	// the pet of the month
+	Pet pets.Pet `json:"pet"`
+
+	// the pets for sale
+	Pets []*pets.Pet `json:"pets"`
+}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
-	"log"
 	"os"
 	"path/filepath"
 	"strings"
@@ -58,6 +57,7 @@ func GenerateModel(modelNames []string, includeModel, includeValidator bool, opt
 			IncludeModel:     includeModel,
 			IncludeValidator: includeValidator,
 			DumpData:         opts.DumpData,
+			logger:           opts.logger(),
 		}
 
 		if err := generator.Generate(); err != nil {
@@ -77,6 +77,7 @@ type modelGenerator struct {
 	IncludeValidator bool
 	Data             interface{}
 	DumpData         bool
+	logger           swag.Logger
 }
 
 func (m *modelGenerator) Generate() error {
@@ -94,14 +95,14 @@ func (m *modelGenerator) Generate() error {
 			return fmt.Errorf("model: %s", err)
 		}
 	}
-	log.Println("generated model", m.Name)
+	m.logger.Printf("generated model %s", m.Name)
 
 	if m.IncludeValidator {
 		if err := m.generateValidator(); err != nil {
 			return fmt.Errorf("validator: %s", err)
 		}
 	}
-	log.Println("generated validator", m.Name)
+	m.logger.Printf("generated validator %s", m.Name)
 	return nil
 }
 
@@ -110,8 +111,8 @@ func (m *modelGenerator) generateValidator() error {
 	if err := modelValidatorTemplate.Execute(buf, m.Data); err != nil {
 		return err
 	}
-	log.Println("rendered validator template:", m.Name)
-	return writeToFile(m.Target, m.Name+"Validator", buf.Bytes())
+	m.logger.Printf("rendered validator template: %s", m.Name)
+	return writeToFile(m.logger, m.Target, m.Name+"Validator", buf.Bytes())
 }
 
 func (m *modelGenerator) generateModel() error {
@@ -120,9 +121,9 @@ func (m *modelGenerator) generateModel() error {
 	if err := modelTemplate.Execute(buf, m.Data); err != nil {
 		return err
 	}
-	log.Println("rendered model template:", m.Name)
+	m.logger.Printf("rendered model template: %s", m.Name)
 
-	return writeToFile(m.Target, m.Name, buf.Bytes())
+	return writeToFile(m.logger, m.Target, m.Name, buf.Bytes())
 }
 
 func makeCodegenModel(name, pkg string, schema spec.Schema, specDoc *spec.Document) *genModel {
@@ -168,6 +169,16 @@ func makeCodegenModel(name, pkg string, schema spec.Schema, specDoc *spec.Docume
 		properties = append(properties, v)
 	}
 
+	var xmlName string
+	var defaultImports []string
+	if schema.XML != nil && schema.XML.Name != "" {
+		xmlName = schema.XML.Name
+		if schema.XML.Namespace != "" {
+			xmlName = schema.XML.Namespace + " " + xmlName
+		}
+		defaultImports = append(defaultImports, "encoding/xml")
+	}
+
 	return &genModel{
 		Package:        filepath.Base(pkg),
 		ClassName:      swag.ToGoName(name),
@@ -178,6 +189,8 @@ func makeCodegenModel(name, pkg string, schema spec.Schema, specDoc *spec.Docume
 		DocString:      modelDocString(swag.ToGoName(name), schema.Description),
 		HumanClassName: swag.ToHumanNameLower(swag.ToGoName(name)),
 		HasValidations: hasValidations,
+		DefaultImports: defaultImports,
+		XMLName:        xmlName,
 	}
 }
 
@@ -193,6 +206,7 @@ type genModel struct {
 	Imports        map[string]string  //`json:"imports,omitempty"`
 	DefaultImports []string           //`json:"defaultImports,omitempty"`
 	HasValidations bool               //`json:"hasValidatins,omitempty"`
+	XMLName        string             //`json:"xmlName,omitempty"`
 }
 
 func modelDocString(className, desc string) string {
@@ -234,16 +248,6 @@ func makeGenModelProperty(path, paramName, accessor, receiver, indexVar, valueEx
 	ctx.HasSliceValidations = len(items) > 0 || hasAdditionalItems
 	ctx.HasValidations = ctx.HasValidations || ctx.HasSliceValidations
 
-	xmlName := paramName
-	if schema.XML != nil {
-		if schema.XML.Name != "" {
-			xmlName = schema.XML.Name
-			if schema.XML.Attribute {
-				xmlName += ",attr"
-			}
-		}
-	}
-
 	return genModelProperty{
 		sharedParam:     ctx,
 		DataType:        ctx.Type,
@@ -261,8 +265,46 @@ func makeGenModelProperty(path, paramName, accessor, receiver, indexVar, valueEx
 		ItemsLen:          len(items),
 		SingleSchemaSlice: singleSchemaSlice,
 
-		XMLName: xmlName,
+		XMLName: xmlTag(paramName, schema),
+	}
+}
+
+// xmlTag builds the value for the xml struct tag of a property from the xml object in its schema.
+// encoding/xml has no way to pick the prefix for a namespace, so the prefix is not part of the tag.
+func xmlTag(paramName string, schema spec.Schema) string {
+	name := paramName
+	var namespace string
+	var attribute, wrapped bool
+	if schema.XML != nil {
+		if schema.XML.Name != "" {
+			name = schema.XML.Name
+		}
+		namespace = schema.XML.Namespace
+		attribute = schema.XML.Attribute
+		wrapped = schema.XML.Wrapped
+	}
+
+	isArray := schema.Type.Contains("array") || (schema.Items != nil && schema.Items.Schema != nil)
+	if isArray && !attribute {
+		// the elements of an array are named after the items, unless they don't define a name
+		itemName := name
+		if schema.Items != nil && schema.Items.Schema != nil && schema.Items.Schema.XML != nil && schema.Items.Schema.XML.Name != "" {
+			itemName = schema.Items.Schema.XML.Name
+		}
+		if wrapped {
+			name = name + ">" + itemName
+		} else {
+			name = itemName
+		}
+	}
+
+	if namespace != "" {
+		name = namespace + " " + name
+	}
+	if attribute {
+		name += ",attr"
 	}
+	return name
 }
 
 // TODO:
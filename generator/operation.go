@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
-	"log"
 	"os"
 	"path/filepath"
 	"strings"
@@ -61,6 +60,7 @@ func GenerateServerOperation(operationNames, tags []string, includeHandler, incl
 			IncludeHandler:       includeHandler,
 			IncludeParameters:    includeParameters,
 			DumpData:             opts.DumpData,
+			logger:               opts.logger(),
 		}
 		if err := generator.Generate(); err != nil {
 			return err
@@ -87,6 +87,7 @@ type operationGenerator struct {
 	IncludeHandler       bool
 	IncludeParameters    bool
 	DumpData             bool
+	logger               swag.Logger
 }
 
 func (o *operationGenerator) Generate() error {
@@ -97,19 +98,31 @@ func (o *operationGenerator) Generate() error {
 	authed := len(o.SecurityRequirements) > 0
 	for _, tag := range o.Operation.Tags {
 		if len(o.Tags) == 0 {
-			operations = append(operations, makeCodegenOperation(o.Name, tag, o.ModelsPackage, o.Principal, o.Target, o.Operation, authed))
+			op, err := makeCodegenOperation(o.Name, tag, o.ModelsPackage, o.Principal, o.Target, o.Operation, authed)
+			if err != nil {
+				return err
+			}
+			operations = append(operations, op)
 			continue
 		}
 		for _, ft := range o.Tags {
 			if ft == tag {
-				operations = append(operations, makeCodegenOperation(o.Name, tag, o.ModelsPackage, o.Principal, o.Target, o.Operation, authed))
+				op, err := makeCodegenOperation(o.Name, tag, o.ModelsPackage, o.Principal, o.Target, o.Operation, authed)
+				if err != nil {
+					return err
+				}
+				operations = append(operations, op)
 				break
 			}
 		}
 
 	}
 	if len(operations) == 0 {
-		operations = append(operations, makeCodegenOperation(o.Name, o.APIPackage, o.ModelsPackage, o.Principal, o.Target, o.Operation, authed))
+		op, err := makeCodegenOperation(o.Name, o.APIPackage, o.ModelsPackage, o.Principal, o.Target, o.Operation, authed)
+		if err != nil {
+			return err
+		}
+		operations = append(operations, op)
 	}
 
 	for _, op := range operations {
@@ -126,18 +139,18 @@ func (o *operationGenerator) Generate() error {
 			if err := o.generateHandler(); err != nil {
 				return fmt.Errorf("handler: %s", err)
 			}
-			log.Println("generated handler", op.Package+"."+op.ClassName)
+			o.logger.Printf("generated handler %s", op.Package+"."+op.ClassName)
 		}
 
 		if o.IncludeParameters && len(o.Operation.Parameters) > 0 {
 			if err := o.generateParameterModel(); err != nil {
 				return fmt.Errorf("parameters: %s", err)
 			}
-			log.Println("generated parameters", op.Package+"."+op.ClassName+"Parameters")
+			o.logger.Printf("generated parameters %s", op.Package+"."+op.ClassName+"Parameters")
 		}
 
 		if len(o.Operation.Parameters) == 0 {
-			log.Println("no parameters for operation", op.Package+"."+op.ClassName)
+			o.logger.Printf("no parameters for operation %s", op.Package+"."+op.ClassName)
 		}
 	}
 
@@ -150,13 +163,13 @@ func (o *operationGenerator) generateHandler() error {
 	if err := operationTemplate.Execute(buf, o.data); err != nil {
 		return err
 	}
-	log.Println("rendered handler template:", o.pkg+"."+o.cname)
+	o.logger.Printf("rendered handler template: %s", o.pkg+"."+o.cname)
 
 	fp := filepath.Join(o.ServerPackage, o.Target)
 	if len(o.Operation.Tags) > 0 {
 		fp = filepath.Join(fp, o.pkg)
 	}
-	return writeToFile(fp, o.Name, buf.Bytes())
+	return writeToFile(o.logger, fp, o.Name, buf.Bytes())
 }
 
 func (o *operationGenerator) generateParameterModel() error {
@@ -165,18 +178,23 @@ func (o *operationGenerator) generateParameterModel() error {
 	if err := parameterTemplate.Execute(buf, o.data); err != nil {
 		return err
 	}
-	log.Println("rendered parameters template:", o.pkg+"."+o.cname+"Parameters")
+	o.logger.Printf("rendered parameters template: %s", o.pkg+"."+o.cname+"Parameters")
 
 	fp := filepath.Join(o.ServerPackage, o.Target)
 	if len(o.Operation.Tags) > 0 {
 		fp = filepath.Join(fp, o.pkg)
 	}
-	return writeToFile(fp, o.Name+"Parameters", buf.Bytes())
+	return writeToFile(o.logger, fp, o.Name+"Parameters", buf.Bytes())
 }
 
-func makeCodegenOperation(name, pkg, modelsPkg, principal, target string, operation spec.Operation, authorized bool) genOperation {
+func makeCodegenOperation(name, pkg, modelsPkg, principal, target string, operation spec.Operation, authorized bool) (genOperation, error) {
 	receiver := "o"
 
+	bi, err := baseImport(filepath.Join(target, ".."))
+	if err != nil {
+		return genOperation{}, err
+	}
+
 	var params, qp, pp, hp, fp []genParameter
 	var hasQueryParams bool
 	for _, p := range operation.Parameters {
@@ -207,9 +225,18 @@ func makeCodegenOperation(name, pkg, modelsPkg, principal, target string, operat
 			returnsContainer = r.Schema.Items != nil || r.Schema.Type.Contains("array")
 			returnsMap = strings.HasPrefix(tn, "map")
 			successModel = tn
+			if r.Schema != nil && r.Schema.Type.Contains("file") {
+				// files are streamed to the client
+				successModel = "httpkit.FileResponse"
+			}
 		}
 	}
 
+	defaultImports := []string{filepath.Join(bi, modelsPkg)}
+	if successModel == "httpkit.FileResponse" {
+		defaultImports = append(defaultImports, "github.com/casualjim/go-swagger/httpkit")
+	}
+
 	prin := principal
 	if prin == "" {
 		prin = "interface{}"
@@ -228,7 +255,7 @@ func makeCodegenOperation(name, pkg, modelsPkg, principal, target string, operat
 		DocString:            operationDocString(swag.ToGoName(name), operation),
 		ReceiverName:         receiver,
 		HumanClassName:       swag.ToHumanNameLower(swag.ToGoName(name)),
-		DefaultImports:       []string{filepath.Join(baseImport(filepath.Join(target, "..")), modelsPkg)},
+		DefaultImports:       defaultImports,
 		Params:               params,
 		Summary:              operation.Summary,
 		QueryParams:          qp,
@@ -245,7 +272,7 @@ func makeCodegenOperation(name, pkg, modelsPkg, principal, target string, operat
 		ReturnsComplexObject: !returnsPrimitive && !returnsFormatted && !returnsContainer && !returnsMap,
 		Authorized:           authorized,
 		Principal:            prin,
-	}
+	}, nil
 }
 
 func operationDocString(name string, operation spec.Operation) string {
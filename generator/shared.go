@@ -3,7 +3,6 @@ package generator
 import (
 	"fmt"
 	"io/ioutil"
-	"log"
 	"os"
 	"path/filepath"
 	"strings"
@@ -53,6 +52,15 @@ type GenOpts struct {
 	TypeMapping   map[string]string
 	Imports       map[string]string
 	DumpData      bool
+	// Logger receives the progress messages of the generator, defaults to standard error
+	Logger swag.Logger
+}
+
+func (g *GenOpts) logger() swag.Logger {
+	if g.Logger == nil {
+		return swag.DefaultLogger()
+	}
+	return g.Logger
 }
 
 type generatorOptions struct {
@@ -136,11 +144,11 @@ func fileExists(target, name string) bool {
 	return !os.IsNotExist(err)
 }
 
-func writeToFileIfNotExist(target, name string, content []byte) error {
+func writeToFileIfNotExist(logger swag.Logger, target, name string, content []byte) error {
 	if fileExists(target, name) {
 		return nil
 	}
-	return writeToFile(target, name, content)
+	return writeToFile(logger, target, name, content)
 }
 
 func formatGoFile(ffn string, content []byte) ([]byte, error) {
@@ -153,11 +161,11 @@ func formatGoFile(ffn string, content []byte) ([]byte, error) {
 	return imports.Process(ffn, content, opts)
 }
 
-func writeToFile(target, name string, content []byte) error {
+func writeToFile(logger swag.Logger, target, name string, content []byte) error {
 	ffn := swag.ToFileName(name) + ".go"
 	res, err := formatGoFile(ffn, content)
 	if err != nil {
-		log.Println(err)
+		logger.Printf("formatting %s failed, writing it unformatted: %v", ffn, err)
 		return writeFile(target, ffn, content)
 	}
 
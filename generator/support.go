@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
-	"log"
 	"os"
 	"path/filepath"
 	"strings"
@@ -90,6 +89,7 @@ func GenerateSupport(name string, modelNames, operationIDs []string, includeUI b
 		ClientPackage: opts.ClientPackage,
 		Principal:     opts.Principal,
 		IncludeUI:     includeUI,
+		logger:        opts.logger(),
 	}
 
 	return generator.Generate()
@@ -109,12 +109,13 @@ type appGenerator struct {
 	Target        string
 	DumpData      bool
 	IncludeUI     bool
+	logger        swag.Logger
 }
 
-func baseImport(tgt string) string {
+func baseImport(tgt string) (string, error) {
 	p, err := filepath.Abs(tgt)
 	if err != nil {
-		log.Fatalln(err)
+		return "", err
 	}
 
 	var pth string
@@ -127,13 +128,16 @@ func baseImport(tgt string) string {
 	}
 
 	if pth == "" {
-		log.Fatalln("target must reside inside a location in the gopath")
+		return "", fmt.Errorf("target %s must reside inside a location in the gopath", tgt)
 	}
-	return pth
+	return pth, nil
 }
 
 func (a *appGenerator) Generate() error {
-	app := a.makeCodegenApp()
+	app, err := a.makeCodegenApp()
+	if err != nil {
+		return err
+	}
 
 	if a.DumpData {
 		bb, _ := json.MarshalIndent(swag.ToDynamicJSON(app), "", "  ")
@@ -144,7 +148,11 @@ func (a *appGenerator) Generate() error {
 	if err := a.generateAPIBuilder(&app); err != nil {
 		return err
 	}
-	app.DefaultImports = append(app.DefaultImports, filepath.Join(baseImport(a.Target), a.ServerPackage, a.APIPackage))
+	bi, err := baseImport(a.Target)
+	if err != nil {
+		return err
+	}
+	app.DefaultImports = append(app.DefaultImports, filepath.Join(bi, a.ServerPackage, a.APIPackage))
 
 	if err := a.generateConfigureAPI(&app); err != nil {
 		return err
@@ -161,7 +169,7 @@ func (a *appGenerator) generateConfigureAPI(app *genApp) error {
 	pth := filepath.Join(a.Target, "cmd", swag.ToCommandName(app.AppName+"Server"))
 	nm := "Configure" + app.AppName
 	if fileExists(pth, nm) {
-		log.Println("skipped (already exists) configure api template:", app.Package+".Configure"+app.AppName)
+		a.logger.Printf("skipped (already exists) configure api template: %s", app.Package+".Configure"+app.AppName)
 		return nil
 	}
 
@@ -169,8 +177,8 @@ func (a *appGenerator) generateConfigureAPI(app *genApp) error {
 	if err := configureAPITemplate.Execute(buf, app); err != nil {
 		return err
 	}
-	log.Println("rendered configure api template:", app.Package+".Configure"+app.AppName)
-	return writeToFileIfNotExist(pth, nm, buf.Bytes())
+	a.logger.Printf("rendered configure api template: %s", app.Package+".Configure"+app.AppName)
+	return writeToFileIfNotExist(a.logger, pth, nm, buf.Bytes())
 }
 
 func (a *appGenerator) generateMain(app *genApp) error {
@@ -178,8 +186,8 @@ func (a *appGenerator) generateMain(app *genApp) error {
 	if err := mainTemplate.Execute(buf, app); err != nil {
 		return err
 	}
-	log.Println("rendered main template:", "server."+app.AppName)
-	return writeToFile(filepath.Join(a.Target, "cmd", swag.ToCommandName(app.AppName+"Server")), "main", buf.Bytes())
+	a.logger.Printf("rendered main template: %s", "server."+app.AppName)
+	return writeToFile(a.logger, filepath.Join(a.Target, "cmd", swag.ToCommandName(app.AppName+"Server")), "main", buf.Bytes())
 }
 
 func (a *appGenerator) generateAPIBuilder(app *genApp) error {
@@ -187,34 +195,47 @@ func (a *appGenerator) generateAPIBuilder(app *genApp) error {
 	if err := builderTemplate.Execute(buf, app); err != nil {
 		return err
 	}
-	log.Println("rendered builder template:", app.Package+"."+app.AppName)
-	return writeToFile(filepath.Join(a.Target, a.ServerPackage, app.Package), app.AppName+"Api", buf.Bytes())
+	a.logger.Printf("rendered builder template: %s", app.Package+"."+app.AppName)
+	return writeToFile(a.logger, filepath.Join(a.Target, a.ServerPackage, app.Package), app.AppName+"Api", buf.Bytes())
 }
 
 var mediaTypeNames = map[string]string{
-	"application/json":        "json",
-	"application/x-yaml":      "yaml",
-	"application/x-protobuf":  "protobuf",
-	"application/x-capnproto": "capnproto",
-	"application/x-thrift":    "thrift",
-	"application/xml":         "xml",
-	"text/xml":                "xml",
-	"text/x-markdown":         "markdown",
-	"text/html":               "html",
-	"text/csv":                "csv",
-	"text/tsv":                "tsv",
-	"text/javascript":         "js",
-	"text/css":                "css",
+	"application/json":                  "json",
+	"application/x-yaml":                "yaml",
+	"application/x-protobuf":            "protobuf",
+	"application/x-capnproto":           "capnproto",
+	"application/x-thrift":              "thrift",
+	"application/xml":                   "xml",
+	"text/xml":                          "xml",
+	"text/x-markdown":                   "markdown",
+	"text/html":                         "html",
+	"text/csv":                          "csv",
+	"text/tsv":                          "tsv",
+	"text/javascript":                   "js",
+	"text/css":                          "css",
+	"text/plain":                        "txt",
+	"application/octet-stream":          "bin",
+	"application/x-www-form-urlencoded": "urlform",
+	"multipart/form-data":               "multipartform",
 }
 
 var knownProducers = map[string]string{
-	"json": "swagger.JSONProducer",
-	"yaml": "swagger.YAMLProducer",
+	"json":    "httpkit.JSONProducer",
+	"yaml":    "httpkit.YAMLProducer",
+	"xml":     "httpkit.XMLProducer",
+	"txt":     "httpkit.TextProducer",
+	"bin":     "httpkit.ByteStreamProducer",
+	"urlform": "httpkit.FormProducer",
 }
 
 var knownConsumers = map[string]string{
-	"json": "swagger.JSONConsumer",
-	"yaml": "swagger.YAMLConsumer",
+	"json":          "httpkit.JSONConsumer",
+	"yaml":          "httpkit.YAMLConsumer",
+	"xml":           "httpkit.XMLConsumer",
+	"txt":           "httpkit.TextConsumer",
+	"bin":           "httpkit.ByteStreamConsumer",
+	"urlform":       "httpkit.FormConsumer",
+	"multipartform": "httpkit.MultipartConsumer",
 }
 
 func getSerializer(sers []genSerGroup, ext string) (*genSerGroup, bool) {
@@ -228,7 +249,7 @@ func getSerializer(sers []genSerGroup, ext string) (*genSerGroup, bool) {
 }
 
 // func makeCodegenApp(operations map[string]spec.Operation, includeUI bool) genApp {
-func (a *appGenerator) makeCodegenApp() genApp {
+func (a *appGenerator) makeCodegenApp() (genApp, error) {
 	sw := a.SpecDoc.Spec()
 	// app := makeCodegenApp(a.Operations, a.IncludeUI)
 	receiver := strings.ToLower(a.Name[:1])
@@ -349,7 +370,11 @@ func (a *appGenerator) makeCodegenApp() genApp {
 	}
 
 	var genMods []genModel
-	defaultImports = append(defaultImports, filepath.Join(baseImport(a.Target), a.ModelsPackage))
+	bi, err := baseImport(a.Target)
+	if err != nil {
+		return genApp{}, err
+	}
+	defaultImports = append(defaultImports, filepath.Join(bi, a.ModelsPackage))
 	for mn, m := range a.Models {
 		mod := *makeCodegenModel(
 			mn,
@@ -372,18 +397,24 @@ func (a *appGenerator) makeCodegenApp() genApp {
 		if len(o.Tags) > 0 {
 			for _, tag := range o.Tags {
 				tns[tag] = struct{}{}
-				op := makeCodegenOperation(on, tag, a.ModelsPackage, a.Principal, a.Target, o, authed)
+				op, err := makeCodegenOperation(on, tag, a.ModelsPackage, a.Principal, a.Target, o, authed)
+				if err != nil {
+					return genApp{}, err
+				}
 				op.ReceiverName = receiver
 				genOps = append(genOps, op)
 			}
 		} else {
-			op := makeCodegenOperation(on, ap, a.ModelsPackage, a.Principal, a.Target, o, authed)
+			op, err := makeCodegenOperation(on, ap, a.ModelsPackage, a.Principal, a.Target, o, authed)
+			if err != nil {
+				return genApp{}, err
+			}
 			op.ReceiverName = receiver
 			genOps = append(genOps, op)
 		}
 	}
 	for k := range tns {
-		defaultImports = append(defaultImports, filepath.Join(baseImport(a.Target), a.ServerPackage, a.APIPackage, k))
+		defaultImports = append(defaultImports, filepath.Join(bi, a.ServerPackage, a.APIPackage, k))
 	}
 
 	defaultConsumes := "application/json"
@@ -417,7 +448,7 @@ func (a *appGenerator) makeCodegenApp() genApp {
 		IncludeUI:           a.IncludeUI,
 		Principal:           a.Principal,
 		SwaggerJSON:         fmt.Sprintf("%#v", jsonb),
-	}
+	}, nil
 }
 
 type genApp struct {
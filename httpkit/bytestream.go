@@ -0,0 +1,57 @@
+package httpkit
+
+import (
+	"encoding"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// ByteStreamConsumer creates a new consumer for application/octet-stream, it reads the body
+// into an io.Writer, a *[]byte or an encoding.BinaryUnmarshaler
+func ByteStreamConsumer() Consumer {
+	return ConsumerFunc(func(reader io.Reader, data interface{}) error {
+		if w, ok := data.(io.Writer); ok {
+			_, err := io.Copy(w, reader)
+			return err
+		}
+
+		b, err := ioutil.ReadAll(reader)
+		if err != nil {
+			return err
+		}
+
+		switch t := data.(type) {
+		case *[]byte:
+			*t = b
+		case encoding.BinaryUnmarshaler:
+			return t.UnmarshalBinary(b)
+		default:
+			return fmt.Errorf("%T is not supported by the byte stream consumer", data)
+		}
+		return nil
+	})
+}
+
+// ByteStreamProducer creates a new producer for application/octet-stream, it writes
+// io.Readers, byte slices and encoding.BinaryMarshalers
+func ByteStreamProducer() Producer {
+	return ProducerFunc(func(writer io.Writer, data interface{}) error {
+		switch t := data.(type) {
+		case io.Reader:
+			_, err := io.Copy(writer, t)
+			return err
+		case []byte:
+			_, err := writer.Write(t)
+			return err
+		case encoding.BinaryMarshaler:
+			b, err := t.MarshalBinary()
+			if err != nil {
+				return err
+			}
+			_, err = writer.Write(b)
+			return err
+		}
+		return fmt.Errorf("%T is not supported by the byte stream producer", data)
+	})
+}
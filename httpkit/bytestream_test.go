@@ -0,0 +1,45 @@
+package httpkit
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var consProdBytes = []byte{0x00, 0x01, 0x02, 0xff}
+
+func TestByteStreamConsumer(t *testing.T) {
+	cons := ByteStreamConsumer()
+
+	var b []byte
+	err := cons.Consume(bytes.NewBuffer(consProdBytes), &b)
+	assert.NoError(t, err)
+	assert.Equal(t, consProdBytes, b)
+
+	var buf bytes.Buffer
+	err = cons.Consume(bytes.NewBuffer(consProdBytes), &buf)
+	assert.NoError(t, err)
+	assert.Equal(t, consProdBytes, buf.Bytes())
+
+	var str string
+	assert.Error(t, cons.Consume(bytes.NewBuffer(consProdBytes), &str))
+}
+
+func TestByteStreamProducer(t *testing.T) {
+	prod := ByteStreamProducer()
+
+	rw := httptest.NewRecorder()
+	err := prod.Produce(rw, consProdBytes)
+	assert.NoError(t, err)
+	assert.Equal(t, consProdBytes, rw.Body.Bytes())
+
+	rw = httptest.NewRecorder()
+	err = prod.Produce(rw, bytes.NewReader(consProdBytes))
+	assert.NoError(t, err)
+	assert.Equal(t, consProdBytes, rw.Body.Bytes())
+
+	rw = httptest.NewRecorder()
+	assert.Error(t, prod.Produce(rw, "text"))
+}
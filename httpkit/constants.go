@@ -5,13 +5,31 @@ const (
 	HeaderContentType = "Content-Type"
 	// HeaderAccept the Accept header
 	HeaderAccept = "Accept"
+	// HeaderContentLength the Content-Length header
+	HeaderContentLength = "Content-Length"
+	// HeaderContentDisposition the Content-Disposition header
+	HeaderContentDisposition = "Content-Disposition"
 
 	charsetKey = "charset"
 
+	// DefaultMaxMemory the default amount of memory a multipart form may use,
+	// the files that don't fit are stored on disk
+	DefaultMaxMemory = 32 << 20
+
 	// DefaultMime the default fallback mime type
 	DefaultMime = "application/octet-stream"
 	// JSONMime the json mime type
 	JSONMime = "application/json"
 	// YAMLMime the yaml mime type
 	YAMLMime = "application/x-yaml"
+	// XMLMime the xml mime type
+	XMLMime = "application/xml"
+	// TextMime the text mime type
+	TextMime = "text/plain"
+	// ByteStreamMime the octet-stream mime type
+	ByteStreamMime = "application/octet-stream"
+	// URLencodedFormMime the url encoded form mime type
+	URLencodedFormMime = "application/x-www-form-urlencoded"
+	// MultipartFormMime the multipart form mime type
+	MultipartFormMime = "multipart/form-data"
 )
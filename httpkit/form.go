@@ -0,0 +1,130 @@
+package httpkit
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/url"
+	"strings"
+)
+
+// FormConsumer creates a new consumer for application/x-www-form-urlencoded bodies,
+// it reads the body into a *url.Values.
+//
+// Form parameters are bound by the request binder, this consumer is used when
+// a body parameter is sent as an url encoded form.
+func FormConsumer() Consumer {
+	return ConsumerFunc(func(reader io.Reader, data interface{}) error {
+		target, ok := data.(*url.Values)
+		if !ok {
+			return fmt.Errorf("%T is not supported by the form consumer", data)
+		}
+
+		b, err := ioutil.ReadAll(reader)
+		if err != nil {
+			return err
+		}
+		values, err := url.ParseQuery(string(b))
+		if err != nil {
+			return err
+		}
+		*target = values
+		return nil
+	})
+}
+
+// FormProducer creates a new producer for application/x-www-form-urlencoded,
+// it writes url.Values, map[string][]string and map[string]string
+func FormProducer() Producer {
+	return ProducerFunc(func(writer io.Writer, data interface{}) error {
+		var values url.Values
+		switch t := data.(type) {
+		case url.Values:
+			values = t
+		case map[string][]string:
+			values = url.Values(t)
+		case map[string]string:
+			values = make(url.Values, len(t))
+			for k, v := range t {
+				values.Set(k, v)
+			}
+		default:
+			return fmt.Errorf("%T is not supported by the form producer", data)
+		}
+		_, err := io.WriteString(writer, values.Encode())
+		return err
+	})
+}
+
+// MultipartBody is a multipart body together with the boundary from its content type.
+// A consumer doesn't get to see the content type header, so this is how the boundary
+// is passed to the multipart consumer. The api middleware passes the multipart bodies
+// to the consumers this way, with the memory limit of its context.
+type MultipartBody struct {
+	io.Reader
+	Boundary string
+	// MaxMemory the memory the form may use, when it's 0 the limit of the consumer is used
+	MaxMemory int64
+}
+
+// MultipartConsumer creates a new consumer for multipart/form-data bodies,
+// it reads the body into a *multipart.Form.
+// The boundary is taken from the reader when it's a *MultipartBody, for any other reader
+// it's guessed from the first line of the body that starts with "--". So a preamble is
+// skipped, unless one of its lines starts with "--" as well.
+//
+// Form parameters are bound by the request binder, this consumer is used when
+// a body parameter is sent as a multipart form.
+func MultipartConsumer() Consumer {
+	return MultipartConsumerWithMemory(DefaultMaxMemory)
+}
+
+// MultipartConsumerWithMemory creates a new consumer for multipart/form-data bodies
+// that keeps up to maxMemory bytes of the form in memory, the files that don't fit
+// are stored in temporary files on disk.
+func MultipartConsumerWithMemory(maxMemory int64) Consumer {
+	return ConsumerFunc(func(reader io.Reader, data interface{}) error {
+		target, ok := data.(*multipart.Form)
+		if !ok {
+			return fmt.Errorf("%T is not supported by the multipart consumer", data)
+		}
+
+		mr, err := multipartReader(reader)
+		if err != nil {
+			return err
+		}
+		limit := maxMemory
+		if body, ok := reader.(*MultipartBody); ok && body.MaxMemory > 0 {
+			limit = body.MaxMemory
+		}
+		form, err := mr.ReadForm(limit)
+		if err != nil {
+			return err
+		}
+		*target = *form
+		return nil
+	})
+}
+
+func multipartReader(reader io.Reader) (*multipart.Reader, error) {
+	if body, ok := reader.(*MultipartBody); ok && body.Boundary != "" {
+		return multipart.NewReader(body.Reader, body.Boundary), nil
+	}
+
+	br := bufio.NewReader(reader)
+	for {
+		line, err := br.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		boundary := strings.TrimSpace(line)
+		if strings.HasPrefix(boundary, "--") && len(boundary) > 2 {
+			return multipart.NewReader(io.MultiReader(strings.NewReader(line), br), boundary[2:]), nil
+		}
+		if err == io.EOF {
+			return nil, fmt.Errorf("the body is not a multipart form")
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package httpkit
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var consProdForm = `id=1&name=Somebody`
+
+func TestFormConsumer(t *testing.T) {
+	cons := FormConsumer()
+	var values url.Values
+	err := cons.Consume(bytes.NewBuffer([]byte(consProdForm)), &values)
+	assert.NoError(t, err)
+	assert.Equal(t, "Somebody", values.Get("name"))
+	assert.Equal(t, "1", values.Get("id"))
+}
+
+func TestFormProducer(t *testing.T) {
+	prod := FormProducer()
+	rw := httptest.NewRecorder()
+	err := prod.Produce(rw, map[string]string{"name": "Somebody", "id": "1"})
+	assert.NoError(t, err)
+	assert.Equal(t, consProdForm, rw.Body.String())
+}
+
+func TestMultipartConsumer(t *testing.T) {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	assert.NoError(t, mw.WriteField("name", "Somebody"))
+	part, err := mw.CreateFormFile("file", "plain.txt")
+	assert.NoError(t, err)
+	part.Write([]byte("the file contents"))
+	assert.NoError(t, mw.Close())
+
+	cons := MultipartConsumer()
+	var form multipart.Form
+	err = cons.Consume(&body, &form)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Somebody"}, form.Value["name"])
+	if assert.Len(t, form.File["file"], 1) {
+		assert.Equal(t, "plain.txt", form.File["file"][0].Filename)
+	}
+
+	assert.Error(t, cons.Consume(bytes.NewBufferString("not multipart"), &form))
+}
+
+func TestMultipartConsumer_Preamble(t *testing.T) {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	assert.NoError(t, mw.WriteField("name", "Somebody"))
+	assert.NoError(t, mw.Close())
+
+	cons := MultipartConsumer()
+	var form multipart.Form
+	err := cons.Consume(bytes.NewBufferString("This is the preamble.\r\n"+body.String()), &form)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"Somebody"}, form.Value["name"])
+	}
+
+	// a preamble line that looks like a boundary needs the boundary to be passed in
+	preamble := "-- not the boundary\r\n" + body.String()
+	assert.Error(t, cons.Consume(bytes.NewBufferString(preamble), &form))
+	err = cons.Consume(&MultipartBody{Reader: bytes.NewBufferString(preamble), Boundary: mw.Boundary()}, &form)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"Somebody"}, form.Value["name"])
+	}
+}
+
+func TestMultipartConsumerWithMemory(t *testing.T) {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	part, err := mw.CreateFormFile("file", "plain.txt")
+	assert.NoError(t, err)
+	part.Write([]byte("more than 8 bytes of file contents"))
+	assert.NoError(t, mw.Close())
+	contents := body.String()
+
+	onDisk := func(cons Consumer, reader io.Reader) bool {
+		var form multipart.Form
+		if !assert.NoError(t, cons.Consume(reader, &form)) || !assert.Len(t, form.File["file"], 1) {
+			return false
+		}
+		defer form.RemoveAll()
+		file, err := form.File["file"][0].Open()
+		if !assert.NoError(t, err) {
+			return false
+		}
+		defer file.Close()
+		_, ok := file.(*os.File)
+		return ok
+	}
+
+	assert.False(t, onDisk(MultipartConsumer(), strings.NewReader(contents)))
+	assert.True(t, onDisk(MultipartConsumerWithMemory(8), strings.NewReader(contents)))
+	// the limit of the body wins
+	body8 := &MultipartBody{Reader: strings.NewReader(contents), Boundary: mw.Boundary(), MaxMemory: 8}
+	assert.True(t, onDisk(MultipartConsumer(), body8))
+}
@@ -1,8 +1,10 @@
 package httpkit
 
 import (
+	"context"
 	"io"
 	"mime/multipart"
+	"net/http"
 
 	"github.com/casualjim/go-swagger/strfmt"
 )
@@ -13,17 +15,44 @@ type File struct {
 	Header *multipart.FileHeader
 }
 
+// FileResponse represents a file that gets streamed to the client.
+// The data is closed once it has been written.
+type FileResponse struct {
+	Data          io.ReadCloser
+	ContentType   string
+	ContentLength int64
+	// Disposition is sent as the Content-Disposition header, eg. attachment; filename="pet.png"
+	Disposition string
+}
+
 // OperationHandlerFunc an adapter for a function to the OperationHandler interface
-type OperationHandlerFunc func(interface{}) (interface{}, error)
+type OperationHandlerFunc func(context.Context, interface{}) (interface{}, error)
 
 // Handle implements the operation handler interface
-func (s OperationHandlerFunc) Handle(data interface{}) (interface{}, error) {
-	return s(data)
+func (s OperationHandlerFunc) Handle(ctx context.Context, data interface{}) (interface{}, error) {
+	return s(ctx, data)
 }
 
-// OperationHandler a handler for a swagger operation
+// OperationHandler a handler for a swagger operation.
+// The context is scoped to the request, it gets cancelled when the client goes away
+// or when the request has been handled.
 type OperationHandler interface {
-	Handle(interface{}) (interface{}, error)
+	Handle(context.Context, interface{}) (interface{}, error)
+}
+
+// ResponderFunc an adapter for a function to the Responder interface
+type ResponderFunc func(http.ResponseWriter, Producer)
+
+// WriteResponse writes the response with the function
+func (fn ResponderFunc) WriteResponse(rw http.ResponseWriter, producer Producer) {
+	fn(rw, producer)
+}
+
+// Responder is returned by an operation handler that writes its own response,
+// the status code and the headers included, instead of leaving that to the api.
+// The producer is the one for the format the client asked for, it is nil when there is none.
+type Responder interface {
+	WriteResponse(http.ResponseWriter, Producer)
 }
 
 // ConsumerFunc represents a function that can be used as a consumer
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/casualjim/go-swagger/errors"
@@ -8,8 +9,8 @@ import (
 	"github.com/casualjim/go-swagger/httpkit/middleware/untyped"
 	"github.com/casualjim/go-swagger/spec"
 	"github.com/casualjim/go-swagger/strfmt"
+	"github.com/casualjim/go-swagger/swag"
 	"github.com/golang/gddo/httputil"
-	"github.com/gorilla/context"
 )
 
 // RequestBinder is an interface for types to implement
@@ -19,12 +20,17 @@ type RequestBinder interface {
 }
 
 // Context is a type safe wrapper around an untyped request context
-// used throughout to store request context with the gorilla context module
+// used throughout to store the values resolved for a request in the request scoped context.Context
 type Context struct {
-	spec    *spec.Document
-	api     RoutableAPI
-	router  Router
-	formats strfmt.Registry
+	spec      *spec.Document
+	api       RoutableAPI
+	router    Router
+	formats   strfmt.Registry
+	logger    swag.Logger
+	maxMemory int64
+
+	errorMapper  ErrorMapper
+	errorMappers map[string]ErrorMapper
 }
 
 type routableUntypedAPI struct {
@@ -34,7 +40,7 @@ type routableUntypedAPI struct {
 	defaultProduces string
 }
 
-func newRoutableUntypedAPI(spec *spec.Document, api *untyped.API, context *Context) *routableUntypedAPI {
+func newRoutableUntypedAPI(spec *spec.Document, api *untyped.API, ctx *Context) *routableUntypedAPI {
 	var handlers map[string]http.Handler
 	if spec == nil || api == nil {
 		return nil
@@ -50,29 +56,29 @@ func newRoutableUntypedAPI(spec *spec.Document, api *untyped.API, context *Conte
 
 				handlers[op.ID] = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 					// lookup route info in the context
-					route, _ := context.RouteInfo(r)
+					route, _ := ctx.RouteInfo(r)
 
 					// bind and validate the request using reflection
-					bound, validation := context.BindAndValidate(r, route)
+					bound, validation := ctx.BindAndValidate(r, route)
 					if validation != nil {
-						context.Respond(w, r, route.Produces, route, validation)
+						ctx.Respond(w, r, route.Produces, route, validation)
 						return
 					}
 
 					// actually handle the request
-					result, err := oh.Handle(bound)
+					result, err := oh.Handle(r.Context(), bound)
 					if err != nil {
 						// respond with failure
-						context.Respond(w, r, route.Produces, route, err)
+						ctx.Respond(w, r, route.Produces, route, err)
 						return
 					}
 
 					// respond with success
-					context.Respond(w, r, route.Produces, route, result)
+					ctx.Respond(w, r, route.Produces, route, result)
 				})
 
 				if len(schemes) > 0 {
-					handlers[op.ID] = newSecureAPI(context, handlers[op.ID])
+					handlers[op.ID] = newSecureAPI(ctx, handlers[op.ID])
 				}
 			}
 		}
@@ -129,8 +135,8 @@ func NewContext(spec *spec.Document, api *untyped.API, routes Router) *Context {
 
 // Serve serves the specified spec with the specified api registrations as a http.Handler
 func Serve(spec *spec.Document, api *untyped.API) http.Handler {
-	context := NewContext(spec, api, nil)
-	return context.APIHandler()
+	ctx := NewContext(spec, api, nil)
+	return ctx.APIHandler()
 }
 
 type contextKey int8
@@ -145,8 +151,61 @@ const (
 	ctxSecurityPrincipal
 
 	ctxConsumer
+	ctxRequestScope
 )
 
+// requestScope holds the values that get resolved once for a request,
+// the router stores it in the context of the request
+type requestScope struct {
+	values map[contextKey]interface{}
+}
+
+// WithRequestScope returns a shallow copy of the request with a context that can hold
+// the values resolved by the middleware for this request.
+// The router does this for every request, the context methods create the scope in place
+// for the requests that don't have one.
+func WithRequestScope(request *http.Request) *http.Request {
+	if _, ok := request.Context().Value(ctxRequestScope).(*requestScope); ok {
+		return request
+	}
+	scope := &requestScope{values: make(map[contextKey]interface{})}
+	return request.WithContext(context.WithValue(request.Context(), ctxRequestScope, scope))
+}
+
+func scopeValue(ctx context.Context, key contextKey) (interface{}, bool) {
+	if scope, ok := ctx.Value(ctxRequestScope).(*requestScope); ok {
+		v, ok := scope.values[key]
+		return v, ok
+	}
+	return nil, false
+}
+
+// setScopeValue stores the value in the scope of the request, a request that didn't get a scope
+// from the router or WithRequestScope gets one in place, so the callers that hold on to the
+// request see the value too
+func setScopeValue(request *http.Request, key contextKey, value interface{}) {
+	scope, ok := request.Context().Value(ctxRequestScope).(*requestScope)
+	if !ok {
+		scope = &requestScope{values: make(map[contextKey]interface{})}
+		*request = *request.WithContext(context.WithValue(request.Context(), ctxRequestScope, scope))
+	}
+	scope.values[key] = value
+}
+
+// MatchedRouteFrom gets the route that was matched for the request the context belongs to
+func MatchedRouteFrom(ctx context.Context) (*MatchedRoute, bool) {
+	if v, ok := scopeValue(ctx, ctxMatchedRoute); ok {
+		route, ok := v.(*MatchedRoute)
+		return route, ok
+	}
+	return nil, false
+}
+
+// SecurityPrincipalFrom gets the authenticated principal for the request the context belongs to
+func SecurityPrincipalFrom(ctx context.Context) (interface{}, bool) {
+	return scopeValue(ctx, ctxSecurityPrincipal)
+}
+
 type contentTypeValue struct {
 	MediaType string
 	Charset   string
@@ -176,7 +235,12 @@ func (c *Context) BindValidRequest(request *http.Request, route *MatchedRoute, b
 			if err := validateContentType(route.Consumes, ct); err != nil {
 				res = append(res, err)
 			}
-			route.Consumer = route.Consumers[ct]
+			route.Consumer = c.consumerFor(route, request, ct)
+		}
+		if len(res) == 0 {
+			if err := c.parseMultipartForm(request, route); err != nil {
+				res = append(res, err)
+			}
 		}
 	}
 
@@ -197,14 +261,16 @@ func (c *Context) BindValidRequest(request *http.Request, route *MatchedRoute, b
 	}
 
 	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
+		err := errors.CompositeValidationError(res...)
+		c.RequestLogger(request, route).Printf("binding request failed: %v", err)
+		return err
 	}
 	return nil
 }
 
 // ContentType gets the parsed value of a content type
 func (c *Context) ContentType(request *http.Request) (string, string, *errors.ParseError) {
-	if v, ok := context.GetOk(request, ctxContentType); ok {
+	if v, ok := scopeValue(request.Context(), ctxContentType); ok {
 		if val, ok := v.(*contentTypeValue); ok {
 			return val.MediaType, val.Charset, nil
 		}
@@ -214,7 +280,7 @@ func (c *Context) ContentType(request *http.Request) (string, string, *errors.Pa
 	if err != nil {
 		return "", "", err
 	}
-	context.Set(request, ctxContentType, &contentTypeValue{mt, cs})
+	setScopeValue(request, ctxContentType, &contentTypeValue{mt, cs})
 	return mt, cs, nil
 }
 
@@ -228,14 +294,14 @@ func (c *Context) LookupRoute(request *http.Request) (*MatchedRoute, bool) {
 
 // RouteInfo tries to match a route for this request
 func (c *Context) RouteInfo(request *http.Request) (*MatchedRoute, bool) {
-	if v, ok := context.GetOk(request, ctxMatchedRoute); ok {
+	if v, ok := scopeValue(request.Context(), ctxMatchedRoute); ok {
 		if val, ok := v.(*MatchedRoute); ok {
 			return val, ok
 		}
 	}
 
 	if route, ok := c.LookupRoute(request); ok {
-		context.Set(request, ctxMatchedRoute, route)
+		setScopeValue(request, ctxMatchedRoute, route)
 		return route, ok
 	}
 
@@ -244,7 +310,7 @@ func (c *Context) RouteInfo(request *http.Request) (*MatchedRoute, bool) {
 
 // ResponseFormat negotiates the response content type
 func (c *Context) ResponseFormat(r *http.Request, offers []string) string {
-	if v, ok := context.GetOk(r, ctxResponseFormat); ok {
+	if v, ok := scopeValue(r.Context(), ctxResponseFormat); ok {
 		if val, ok := v.(string); ok {
 			return val
 		}
@@ -252,7 +318,7 @@ func (c *Context) ResponseFormat(r *http.Request, offers []string) string {
 
 	format := httputil.NegotiateContentType(r, offers, "")
 	if format != "" {
-		context.Set(r, ctxResponseFormat, format)
+		setScopeValue(r, ctxResponseFormat, format)
 	}
 	return format
 }
@@ -267,16 +333,19 @@ func (c *Context) Authorize(request *http.Request, route *MatchedRoute) (interfa
 	if len(route.Authenticators) == 0 {
 		return nil, nil
 	}
-	if v, ok := context.GetOk(request, ctxSecurityPrincipal); ok {
+	if v, ok := scopeValue(request.Context(), ctxSecurityPrincipal); ok {
 		return v, nil
 	}
 
 	for _, authenticator := range route.Authenticators {
 		applies, usr, err := authenticator.Authenticate(request)
 		if !applies || err != nil || usr == nil {
+			if applies && err != nil {
+				c.RequestLogger(request, route).Printf("authentication failed: %v", err)
+			}
 			continue
 		}
-		context.Set(request, ctxSecurityPrincipal, usr)
+		setScopeValue(request, ctxSecurityPrincipal, usr)
 		return usr, nil
 	}
 
@@ -285,7 +354,7 @@ func (c *Context) Authorize(request *http.Request, route *MatchedRoute) (interfa
 
 // BindAndValidate binds and validates the request
 func (c *Context) BindAndValidate(request *http.Request, matched *MatchedRoute) (interface{}, error) {
-	if v, ok := context.GetOk(request, ctxBoundParams); ok {
+	if v, ok := scopeValue(request.Context(), ctxBoundParams); ok {
 		if val, ok := v.(*validation); ok {
 			if len(val.result) > 0 {
 				return val.bound, errors.CompositeValidationError(val.result...)
@@ -295,10 +364,12 @@ func (c *Context) BindAndValidate(request *http.Request, matched *MatchedRoute)
 	}
 	result := validateRequest(c, request, matched)
 	if result != nil {
-		context.Set(request, ctxBoundParams, result)
+		setScopeValue(request, ctxBoundParams, result)
 	}
 	if len(result.result) > 0 {
-		return result.bound, errors.CompositeValidationError(result.result...)
+		err := errors.CompositeValidationError(result.result...)
+		c.RequestLogger(request, matched).Printf("binding request failed: %v", err)
+		return result.bound, err
 	}
 	return result.bound, nil
 }
@@ -323,15 +394,33 @@ func (c *Context) Respond(rw http.ResponseWriter, r *http.Request, produces []st
 	if err, ok := data.(error); ok {
 		if format == "" {
 			rw.Header().Set(httpkit.HeaderContentType, httpkit.JSONMime)
+		} else if prod, ok := c.api.ProducersFor(offers)[format]; ok {
+			// the error gets rendered in the format the client asked for
+			r = errors.WithProducer(r, format, prod)
 		}
 		if route == nil || route.Operation == nil {
 			c.api.ServeErrorFor("")(rw, r, err)
 			return
 		}
+		if c.respondErrorModel(rw, r, format, route, err) {
+			return
+		}
 		c.api.ServeErrorFor(route.Operation.ID)(rw, r, err)
 		return
 	}
+	if responder, ok := data.(httpkit.Responder); ok {
+		producers := c.api.ProducersFor(offers)
+		if route != nil && route.Operation != nil {
+			producers = route.Producers
+		}
+		responder.WriteResponse(rw, producers[format])
+		return
+	}
 	if route == nil || route.Operation == nil {
+		if file, ok := fileResponse(data); ok {
+			c.streamFile(rw, r, route, http.StatusOK, file)
+			return
+		}
 		rw.WriteHeader(200)
 		if r.Method == "HEAD" {
 			return
@@ -348,6 +437,10 @@ func (c *Context) Respond(rw http.ResponseWriter, r *http.Request, produces []st
 	}
 
 	if _, code, ok := route.Operation.SuccessResponse(); ok {
+		if file, ok := fileResponse(data); ok {
+			c.streamFile(rw, r, route, code, file)
+			return
+		}
 		rw.WriteHeader(code)
 		if code == 201 || code == 204 || r.Method == "HEAD" {
 			return
@@ -8,7 +8,6 @@ import (
 
 	"github.com/casualjim/go-swagger/httpkit"
 	"github.com/casualjim/go-swagger/internal/testing/petstore"
-	"github.com/gorilla/context"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -39,7 +38,7 @@ func TestContextAuthorize(t *testing.T) {
 
 	request, _ := httpkit.JSONRequest("GET", "/pets", nil)
 
-	v, ok := context.GetOk(request, ctxSecurityPrincipal)
+	v, ok := scopeValue(request.Context(), ctxSecurityPrincipal)
 	assert.False(t, ok)
 	assert.Nil(t, v)
 
@@ -49,7 +48,7 @@ func TestContextAuthorize(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, p)
 
-	v, ok = context.GetOk(request, ctxSecurityPrincipal)
+	v, ok = scopeValue(request.Context(), ctxSecurityPrincipal)
 	assert.False(t, ok)
 	assert.Nil(t, v)
 
@@ -58,7 +57,7 @@ func TestContextAuthorize(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, p)
 
-	v, ok = context.GetOk(request, ctxSecurityPrincipal)
+	v, ok = scopeValue(request.Context(), ctxSecurityPrincipal)
 	assert.False(t, ok)
 	assert.Nil(t, v)
 
@@ -67,7 +66,7 @@ func TestContextAuthorize(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "admin", p)
 
-	v, ok = context.GetOk(request, ctxSecurityPrincipal)
+	v, ok = scopeValue(request.Context(), ctxSecurityPrincipal)
 	assert.True(t, ok)
 	assert.Equal(t, "admin", v)
 
@@ -86,7 +85,7 @@ func TestContextBindAndValidate(t *testing.T) {
 	request.Header.Add("Accept", "*/*")
 	request.Header.Add("content-type", "text/html")
 
-	v, ok := context.GetOk(request, ctxBoundParams)
+	v, ok := scopeValue(request.Context(), ctxBoundParams)
 	assert.False(t, ok)
 	assert.Nil(t, v)
 
@@ -95,7 +94,7 @@ func TestContextBindAndValidate(t *testing.T) {
 	assert.NotNil(t, data)
 	assert.NotNil(t, result)
 
-	v, ok = context.GetOk(request, ctxBoundParams)
+	v, ok = scopeValue(request.Context(), ctxBoundParams)
 	assert.True(t, ok)
 	assert.NotNil(t, v)
 
@@ -160,6 +159,25 @@ func TestContextRender(t *testing.T) {
 	assert.Equal(t, 204, recorder.Code)
 }
 
+func TestContextRender_Responder(t *testing.T) {
+	spec, api := petstore.NewAPI(t)
+	ctx := NewContext(spec, api, nil)
+	ctx.router = DefaultRouter(spec, ctx.api)
+
+	request, _ := http.NewRequest("POST", "/pets", nil)
+	request.Header.Set(httpkit.HeaderAccept, httpkit.JSONMime)
+	ri, _ := ctx.RouteInfo(request)
+
+	recorder := httptest.NewRecorder()
+	ctx.Respond(recorder, request, ri.Produces, ri, httpkit.ResponderFunc(func(rw http.ResponseWriter, producer httpkit.Producer) {
+		rw.WriteHeader(http.StatusCreated)
+		producer.Produce(rw, map[string]interface{}{"name": "hello"})
+	}))
+	assert.Equal(t, 201, recorder.Code)
+	assert.Equal(t, httpkit.JSONMime, recorder.Header().Get(httpkit.HeaderContentType))
+	assert.Equal(t, "{\"name\":\"hello\"}\n", recorder.Body.String())
+}
+
 func TestContextValidResponseFormat(t *testing.T) {
 	ct := "application/json"
 	spec, api := petstore.NewAPI(t)
@@ -170,7 +188,7 @@ func TestContextValidResponseFormat(t *testing.T) {
 	request.Header.Set(httpkit.HeaderAccept, ct)
 
 	// check there's nothing there
-	cached, ok := context.GetOk(request, ctxResponseFormat)
+	cached, ok := scopeValue(request.Context(), ctxResponseFormat)
 	assert.False(t, ok)
 	assert.Empty(t, cached)
 
@@ -179,7 +197,7 @@ func TestContextValidResponseFormat(t *testing.T) {
 	assert.Equal(t, ct, mt)
 
 	// check it was cached
-	cached, ok = context.GetOk(request, ctxResponseFormat)
+	cached, ok = scopeValue(request.Context(), ctxResponseFormat)
 	assert.True(t, ok)
 	assert.Equal(t, ct, cached)
 
@@ -199,7 +217,7 @@ func TestContextInvalidResponseFormat(t *testing.T) {
 	request.Header.Set(httpkit.HeaderAccept, ct)
 
 	// check there's nothing there
-	cached, ok := context.GetOk(request, ctxResponseFormat)
+	cached, ok := scopeValue(request.Context(), ctxResponseFormat)
 	assert.False(t, ok)
 	assert.Empty(t, cached)
 
@@ -208,7 +226,7 @@ func TestContextInvalidResponseFormat(t *testing.T) {
 	assert.Empty(t, mt)
 
 	// check it was cached
-	cached, ok = context.GetOk(request, ctxResponseFormat)
+	cached, ok = scopeValue(request.Context(), ctxResponseFormat)
 	assert.False(t, ok)
 	assert.Empty(t, cached)
 
@@ -225,7 +243,7 @@ func TestContextValidRoute(t *testing.T) {
 	request, _ := http.NewRequest("GET", "/pets", nil)
 
 	// check there's nothing there
-	_, ok := context.GetOk(request, ctxMatchedRoute)
+	_, ok := scopeValue(request.Context(), ctxMatchedRoute)
 	assert.False(t, ok)
 
 	matched, ok := ctx.RouteInfo(request)
@@ -233,7 +251,7 @@ func TestContextValidRoute(t *testing.T) {
 	assert.NotNil(t, matched)
 
 	// check it was cached
-	_, ok = context.GetOk(request, ctxMatchedRoute)
+	_, ok = scopeValue(request.Context(), ctxMatchedRoute)
 	assert.True(t, ok)
 
 	matched, ok = ctx.RouteInfo(request)
@@ -249,7 +267,7 @@ func TestContextInvalidRoute(t *testing.T) {
 	request, _ := http.NewRequest("DELETE", "pets", nil)
 
 	// check there's nothing there
-	_, ok := context.GetOk(request, ctxMatchedRoute)
+	_, ok := scopeValue(request.Context(), ctxMatchedRoute)
 	assert.False(t, ok)
 
 	matched, ok := ctx.RouteInfo(request)
@@ -257,7 +275,7 @@ func TestContextInvalidRoute(t *testing.T) {
 	assert.Nil(t, matched)
 
 	// check it was cached
-	_, ok = context.GetOk(request, ctxMatchedRoute)
+	_, ok = scopeValue(request.Context(), ctxMatchedRoute)
 	assert.False(t, ok)
 
 	matched, ok = ctx.RouteInfo(request)
@@ -273,7 +291,7 @@ func TestContextValidContentType(t *testing.T) {
 	request.Header.Set(httpkit.HeaderContentType, ct)
 
 	// check there's nothing there
-	_, ok := context.GetOk(request, ctxContentType)
+	_, ok := scopeValue(request.Context(), ctxContentType)
 	assert.False(t, ok)
 
 	// trigger the parse
@@ -282,7 +300,7 @@ func TestContextValidContentType(t *testing.T) {
 	assert.Equal(t, ct, mt)
 
 	// check it was cached
-	_, ok = context.GetOk(request, ctxContentType)
+	_, ok = scopeValue(request.Context(), ctxContentType)
 	assert.True(t, ok)
 
 	// check if the cast works and fetch from cache too
@@ -299,7 +317,7 @@ func TestContextInvalidContentType(t *testing.T) {
 	request.Header.Set(httpkit.HeaderContentType, ct)
 
 	// check there's nothing there
-	_, ok := context.GetOk(request, ctxContentType)
+	_, ok := scopeValue(request.Context(), ctxContentType)
 	assert.False(t, ok)
 
 	// trigger the parse
@@ -308,10 +326,30 @@ func TestContextInvalidContentType(t *testing.T) {
 	assert.Empty(t, mt)
 
 	// check it was not cached
-	_, ok = context.GetOk(request, ctxContentType)
+	_, ok = scopeValue(request.Context(), ctxContentType)
 	assert.False(t, ok)
 
 	// check if the failure continues
 	_, _, err = ctx.ContentType(request)
 	assert.Error(t, err)
 }
+
+func TestRequestScope(t *testing.T) {
+	request, _ := http.NewRequest("GET", "/pets", nil)
+	_, ok := scopeValue(request.Context(), ctxMatchedRoute)
+	assert.False(t, ok)
+
+	// the scope is created in place for a request without one
+	setScopeValue(request, ctxResponseFormat, httpkit.JSONMime)
+	v, ok := scopeValue(request.Context(), ctxResponseFormat)
+	assert.True(t, ok)
+	assert.Equal(t, httpkit.JSONMime, v)
+
+	// an existing scope is kept
+	scoped := WithRequestScope(request)
+	assert.Equal(t, request, scoped)
+	setScopeValue(scoped, ctxContentType, "text/plain")
+	v, ok = scopeValue(request.Context(), ctxContentType)
+	assert.True(t, ok)
+	assert.Equal(t, "text/plain", v)
+}
@@ -6,12 +6,11 @@ Pseudo middleware handler
   	"net/http"
 
   	"github.com/casualjim/go-swagger/errors"
-  	"github.com/gorilla/context"
   )
 
   func newCompleteMiddleware(ctx *Context) http.Handler {
   	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
-  		defer context.Clear(r)
+  		r = WithRequestScope(r)
 
   		// use context to lookup routes
   		if matched, ok := ctx.RouteInfo(r); ok {
@@ -29,7 +28,7 @@ Pseudo middleware handler
   				return
   			}
 
-  			result, err := matched.Handler.Handle(bound)
+  			result, err := matched.Handler.Handle(r.Context(), bound)
   			if err != nil {
   				ctx.Respond(rw, r, matched.Produces, matched, err)
   				return
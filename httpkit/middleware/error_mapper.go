@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/casualjim/go-swagger/errors"
+	"github.com/casualjim/go-swagger/spec"
+)
+
+// ErrorMapper maps an error onto the model of the error response an operation declares for it.
+// The response is the one declared for the status code of the error or the default response.
+// Returning nil leaves the error to the ServeError function of the api.
+type ErrorMapper func(err errors.Error, response *spec.Response) interface{}
+
+// SetErrorMapper sets the error mapper used for all the operations
+func (c *Context) SetErrorMapper(mapper ErrorMapper) {
+	c.errorMapper = mapper
+}
+
+// SetErrorMapperFor sets the error mapper for a single operation,
+// it takes precedence over the error mapper for all the operations
+func (c *Context) SetErrorMapperFor(operationID string, mapper ErrorMapper) {
+	if c.errorMappers == nil {
+		c.errorMappers = make(map[string]ErrorMapper)
+	}
+	c.errorMappers[operationID] = mapper
+}
+
+func (c *Context) errorMapperFor(operationID string) ErrorMapper {
+	if mapper, ok := c.errorMappers[operationID]; ok && mapper != nil {
+		return mapper
+	}
+	return c.errorMapper
+}
+
+// errorResponseFor finds the response the operation declares for a status code
+func errorResponseFor(operation *spec.Operation, code int) (*spec.Response, bool) {
+	if operation.Responses == nil {
+		return nil, false
+	}
+	if resp, ok := operation.Responses.StatusCodeResponses[code]; ok {
+		return &resp, resp.Schema != nil
+	}
+	if resp := operation.Responses.Default; resp != nil {
+		return resp, resp.Schema != nil
+	}
+	return nil, false
+}
+
+// respondErrorModel renders the error as the model declared in the spec for the error response,
+// it returns false when there is nothing to map the error onto
+func (c *Context) respondErrorModel(rw http.ResponseWriter, r *http.Request, format string, route *MatchedRoute, err error) bool {
+	mapper := c.errorMapperFor(route.Operation.ID)
+	if mapper == nil {
+		return false
+	}
+
+	code := int(errors.ProblemFor(err).Code)
+	response, ok := errorResponseFor(route.Operation, code)
+	if !ok {
+		return false
+	}
+	prod, ok := route.Producers[format]
+	if !ok {
+		return false
+	}
+
+	apiErr, ok := err.(errors.Error)
+	if !ok {
+		apiErr = errors.New(int32(code), err.Error())
+	}
+	model := mapper(apiErr, response)
+	if model == nil {
+		return false
+	}
+
+	rw.WriteHeader(code)
+	if r.Method == "HEAD" {
+		return true
+	}
+	if err := prod.Produce(rw, model); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+	return true
+}
@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/casualjim/go-swagger/errors"
+	"github.com/casualjim/go-swagger/httpkit"
+	"github.com/casualjim/go-swagger/internal/testing/petstore"
+	"github.com/casualjim/go-swagger/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+type errorModel struct {
+	Status int32  `json:"status"`
+	Reason string `json:"reason"`
+}
+
+func TestContextErrorMapper(t *testing.T) {
+	doc, api := petstore.NewAPI(t)
+	ctx := NewContext(doc, api, nil)
+	ctx.router = DefaultRouter(doc, ctx.api)
+
+	request, _ := http.NewRequest("GET", "/pets", nil)
+	request.Header.Set(httpkit.HeaderAccept, httpkit.JSONMime)
+	ri, _ := ctx.RouteInfo(request)
+
+	// without a mapper the api serves the error
+	recorder := httptest.NewRecorder()
+	ctx.Respond(recorder, request, ri.Produces, ri, errors.NotFound("no pets"))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Equal(t, `{"type":"about:blank","title":"Not Found","status":404,"detail":"no pets","instance":"/pets","code":404,"message":"no pets"}`, recorder.Body.String())
+
+	ctx.SetErrorMapper(func(err errors.Error, response *spec.Response) interface{} {
+		return &errorModel{Status: err.Code(), Reason: "global: " + err.Error()}
+	})
+	recorder = httptest.NewRecorder()
+	ctx.Respond(recorder, request, ri.Produces, ri, errors.NotFound("no pets"))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Equal(t, `{"status":404,"reason":"global: no pets"}`+"\n", recorder.Body.String())
+
+	// the operation mapper takes precedence
+	ctx.SetErrorMapperFor("getAllPets", func(err errors.Error, response *spec.Response) interface{} {
+		assert.Equal(t, "Unexpected error", response.Description)
+		return &errorModel{Status: err.Code(), Reason: "getAllPets: " + err.Error()}
+	})
+	recorder = httptest.NewRecorder()
+	ctx.Respond(recorder, request, ri.Produces, ri, errors.NotFound("no pets"))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Equal(t, `{"status":404,"reason":"getAllPets: no pets"}`+"\n", recorder.Body.String())
+
+	// returning nil leaves the error to the api
+	ctx.SetErrorMapperFor("getAllPets", func(err errors.Error, response *spec.Response) interface{} {
+		return nil
+	})
+	ctx.SetErrorMapper(nil)
+	recorder = httptest.NewRecorder()
+	ctx.Respond(recorder, request, ri.Produces, ri, errors.NotFound("no pets"))
+	assert.Equal(t, `{"type":"about:blank","title":"Not Found","status":404,"detail":"no pets","instance":"/pets","code":404,"message":"no pets"}`, recorder.Body.String())
+}
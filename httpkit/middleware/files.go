@@ -0,0 +1,119 @@
+package middleware
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/casualjim/go-swagger/errors"
+	"github.com/casualjim/go-swagger/httpkit"
+)
+
+// SetMaxMemory sets the amount of memory a multipart form may use,
+// the uploaded files that don't fit are stored in temporary files on disk.
+// The limit applies to the form parameters and to the multipart bodies read by a consumer.
+func (c *Context) SetMaxMemory(maxMemory int64) {
+	c.maxMemory = maxMemory
+}
+
+// MaxMemory returns the amount of memory a multipart form may use
+func (c *Context) MaxMemory() int64 {
+	if c.maxMemory <= 0 {
+		return httpkit.DefaultMaxMemory
+	}
+	return c.maxMemory
+}
+
+// parseMultipartForm reads a multipart body with the configured memory limit,
+// so that the binders further down the chain find the form already parsed.
+// The router removes the temporary files once the request has been handled.
+// When the operation has a body parameter the multipart body is left to its consumer.
+func (c *Context) parseMultipartForm(request *http.Request, route *MatchedRoute) error {
+	if request.MultipartForm != nil {
+		return nil
+	}
+	mt, _, err := httpkit.ContentType(request.Header)
+	if err != nil || mt != httpkit.MultipartFormMime || hasBodyParam(route) {
+		return nil
+	}
+	if err := request.ParseMultipartForm(c.MaxMemory()); err != nil {
+		return errors.NewParseError("", "formData", "", err)
+	}
+	return nil
+}
+
+func hasBodyParam(route *MatchedRoute) bool {
+	if route == nil {
+		return false
+	}
+	for _, param := range route.Parameters {
+		if param.In == "body" {
+			return true
+		}
+	}
+	return false
+}
+
+// consumerFor the consumer of the route for the media type, the consumer of a multipart body
+// gets the body as a *httpkit.MultipartBody with the boundary from the content type and
+// the memory limit set with SetMaxMemory
+func (c *Context) consumerFor(route *MatchedRoute, request *http.Request, mediaType string) httpkit.Consumer {
+	consumer := route.Consumers[mediaType]
+	if consumer == nil || mediaType != httpkit.MultipartFormMime {
+		return consumer
+	}
+	_, params, err := mime.ParseMediaType(request.Header.Get(httpkit.HeaderContentType))
+	if err != nil || params["boundary"] == "" {
+		return consumer
+	}
+	return httpkit.ConsumerFunc(func(reader io.Reader, data interface{}) error {
+		body := &httpkit.MultipartBody{Reader: reader, Boundary: params["boundary"], MaxMemory: c.maxMemory}
+		return consumer.Consume(body, data)
+	})
+}
+
+func removeMultipartForm(request *http.Request) {
+	if request.MultipartForm != nil {
+		request.MultipartForm.RemoveAll()
+	}
+}
+
+// fileResponse returns the file to stream when the data returned by a handler is a file
+func fileResponse(data interface{}) (*httpkit.FileResponse, bool) {
+	switch t := data.(type) {
+	case *httpkit.FileResponse:
+		return t, t != nil && t.Data != nil
+	case httpkit.FileResponse:
+		return &t, t.Data != nil
+	case io.ReadCloser:
+		return &httpkit.FileResponse{Data: t}, true
+	}
+	return nil, false
+}
+
+// streamFile copies the file to the response without buffering it
+func (c *Context) streamFile(rw http.ResponseWriter, r *http.Request, route *MatchedRoute, code int, file *httpkit.FileResponse) {
+	defer file.Data.Close()
+
+	if file.ContentType != "" {
+		rw.Header().Set(httpkit.HeaderContentType, file.ContentType)
+	} else if rw.Header().Get(httpkit.HeaderContentType) == "" {
+		rw.Header().Set(httpkit.HeaderContentType, httpkit.ByteStreamMime)
+	}
+	if file.ContentLength > 0 {
+		rw.Header().Set(httpkit.HeaderContentLength, strconv.FormatInt(file.ContentLength, 10))
+	}
+	if file.Disposition != "" {
+		rw.Header().Set(httpkit.HeaderContentDisposition, file.Disposition)
+	}
+	rw.WriteHeader(code)
+	if r.Method == "HEAD" {
+		return
+	}
+
+	// the headers are gone already, the best we can do is to let somebody know
+	if _, err := io.Copy(rw, file.Data); err != nil {
+		c.RequestLogger(r, route).Printf("streaming file failed: %v", err)
+	}
+}
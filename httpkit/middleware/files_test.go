@@ -0,0 +1,126 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/casualjim/go-swagger/httpkit"
+	"github.com/casualjim/go-swagger/internal/testing/petstore"
+	"github.com/casualjim/go-swagger/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+type closeRecorder struct {
+	*strings.Reader
+	closed bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestContextRenderFile(t *testing.T) {
+	spec, api := petstore.NewAPI(t)
+	ctx := NewContext(spec, api, nil)
+	ctx.router = DefaultRouter(spec, ctx.api)
+
+	request, _ := http.NewRequest("GET", "/pets", nil)
+	request.Header.Set(httpkit.HeaderAccept, httpkit.JSONMime)
+	ri, _ := ctx.RouteInfo(request)
+
+	data := &closeRecorder{Reader: strings.NewReader("the file contents")}
+	recorder := httptest.NewRecorder()
+	ctx.Respond(recorder, request, ri.Produces, ri, &httpkit.FileResponse{
+		Data:          data,
+		ContentType:   "text/plain",
+		ContentLength: 17,
+		Disposition:   `attachment; filename="pets.txt"`,
+	})
+	assert.Equal(t, 200, recorder.Code)
+	assert.Equal(t, "the file contents", recorder.Body.String())
+	assert.Equal(t, "text/plain", recorder.Header().Get(httpkit.HeaderContentType))
+	assert.Equal(t, "17", recorder.Header().Get(httpkit.HeaderContentLength))
+	assert.Equal(t, `attachment; filename="pets.txt"`, recorder.Header().Get(httpkit.HeaderContentDisposition))
+	assert.True(t, data.closed)
+
+	// a plain reader gets the negotiated content type
+	recorder = httptest.NewRecorder()
+	ctx.Respond(recorder, request, ri.Produces, ri, ioutil.NopCloser(strings.NewReader("streamed")))
+	assert.Equal(t, 200, recorder.Code)
+	assert.Equal(t, "streamed", recorder.Body.String())
+	assert.Equal(t, httpkit.JSONMime, recorder.Header().Get(httpkit.HeaderContentType))
+}
+
+func TestContextParseMultipartForm(t *testing.T) {
+	spec, api := petstore.NewAPI(t)
+	ctx := NewContext(spec, api, nil)
+	assert.EqualValues(t, httpkit.DefaultMaxMemory, ctx.MaxMemory())
+	ctx.SetMaxMemory(8)
+	assert.EqualValues(t, 8, ctx.MaxMemory())
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	part, err := mw.CreateFormFile("file", "pets.txt")
+	assert.NoError(t, err)
+	part.Write([]byte("more than 8 bytes of file contents"))
+	assert.NoError(t, mw.Close())
+
+	request, _ := http.NewRequest("POST", "/pets", &body)
+	request.Header.Set(httpkit.HeaderContentType, mw.FormDataContentType())
+	assert.NoError(t, ctx.parseMultipartForm(request, nil))
+	if assert.NotNil(t, request.MultipartForm) {
+		defer removeMultipartForm(request)
+		file, header, err := request.FormFile("file")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "pets.txt", header.Filename)
+			// the file didn't fit in memory so it got stored on disk
+			_, onDisk := file.(*os.File)
+			assert.True(t, onDisk)
+			file.Close()
+		}
+	}
+}
+
+func TestContextMultipartBody(t *testing.T) {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	assert.NoError(t, mw.WriteField("name", "Somebody"))
+	assert.NoError(t, mw.Close())
+
+	var boundary string
+	var maxMemory int64
+	route := &MatchedRoute{}
+	route.Consumers = map[string]httpkit.Consumer{
+		httpkit.MultipartFormMime: httpkit.ConsumerFunc(func(reader io.Reader, data interface{}) error {
+			if mb, ok := reader.(*httpkit.MultipartBody); ok {
+				boundary, maxMemory = mb.Boundary, mb.MaxMemory
+			}
+			return nil
+		}),
+	}
+	route.Parameters = map[string]spec.Parameter{"Form": *spec.BodyParam("form", nil)}
+
+	sp, api := petstore.NewAPI(t)
+	ctx := NewContext(sp, api, nil)
+	request, _ := http.NewRequest("POST", "/pets", &body)
+	request.Header.Set(httpkit.HeaderContentType, mw.FormDataContentType())
+
+	// the body is left to the consumer of the body parameter
+	assert.NoError(t, ctx.parseMultipartForm(request, route))
+	assert.Nil(t, request.MultipartForm)
+	assert.NoError(t, ctx.consumerFor(route, request, httpkit.MultipartFormMime).Consume(request.Body, nil))
+	assert.Equal(t, mw.Boundary(), boundary)
+	assert.EqualValues(t, 0, maxMemory)
+
+	ctx.SetMaxMemory(8)
+	assert.NoError(t, ctx.consumerFor(route, request, httpkit.MultipartFormMime).Consume(request.Body, nil))
+	assert.EqualValues(t, 8, maxMemory)
+}
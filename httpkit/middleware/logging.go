@@ -0,0 +1,135 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/casualjim/go-swagger/swag"
+)
+
+// statusRecorder captures the status code written by the handlers further down the chain
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+// recordStatus wraps the response writer in a status recorder, the returned writer still
+// implements the http.Flusher, http.Hijacker and http.CloseNotifier interfaces of the
+// wrapped writer so streaming responses and connection upgrades keep working
+func recordStatus(w http.ResponseWriter) (http.ResponseWriter, *statusRecorder) {
+	rec := &statusRecorder{ResponseWriter: w}
+	f, isFlusher := w.(http.Flusher)
+	h, isHijacker := w.(http.Hijacker)
+	c, isCloseNotifier := w.(http.CloseNotifier)
+
+	switch {
+	case isFlusher && isHijacker && isCloseNotifier:
+		return struct {
+			*statusRecorder
+			http.Flusher
+			http.Hijacker
+			http.CloseNotifier
+		}{rec, f, h, c}, rec
+	case isFlusher && isHijacker:
+		return struct {
+			*statusRecorder
+			http.Flusher
+			http.Hijacker
+		}{rec, f, h}, rec
+	case isFlusher && isCloseNotifier:
+		return struct {
+			*statusRecorder
+			http.Flusher
+			http.CloseNotifier
+		}{rec, f, c}, rec
+	case isHijacker && isCloseNotifier:
+		return struct {
+			*statusRecorder
+			http.Hijacker
+			http.CloseNotifier
+		}{rec, h, c}, rec
+	case isFlusher:
+		return struct {
+			*statusRecorder
+			http.Flusher
+		}{rec, f}, rec
+	case isHijacker:
+		return struct {
+			*statusRecorder
+			http.Hijacker
+		}{rec, h}, rec
+	case isCloseNotifier:
+		return struct {
+			*statusRecorder
+			http.CloseNotifier
+		}{rec, c}, rec
+	}
+	return rec, rec
+}
+
+func (s *statusRecorder) WriteHeader(code int) {
+	if s.status == 0 {
+		s.status = code
+	}
+	s.ResponseWriter.WriteHeader(code)
+}
+
+func (s *statusRecorder) Write(data []byte) (int, error) {
+	if s.status == 0 {
+		s.status = http.StatusOK
+	}
+	return s.ResponseWriter.Write(data)
+}
+
+func (s *statusRecorder) Status() int {
+	if s.status == 0 {
+		return http.StatusOK
+	}
+	return s.status
+}
+
+func routeFields(request *http.Request, route *MatchedRoute) swag.Fields {
+	fields := swag.Fields{
+		"method": request.Method,
+		"path":   request.URL.Path,
+	}
+	if route != nil {
+		if route.PathPattern != "" {
+			fields["route"] = route.PathPattern
+		}
+		if route.Operation != nil && route.Operation.ID != "" {
+			fields["operationId"] = route.Operation.ID
+		}
+	}
+	return fields
+}
+
+// SetLogger sets the logger used by this context, passing nil silences the logging.
+// The requests are only logged when a logger is set.
+func (c *Context) SetLogger(logger swag.Logger) {
+	if logger == nil {
+		logger = swag.DiscardLogger()
+	}
+	c.logger = logger
+}
+
+// Logger returns the logger used by this context, it discards the messages when no logger was set
+func (c *Context) Logger() swag.Logger {
+	if c.logger == nil {
+		c.logger = swag.DiscardLogger()
+	}
+	return c.logger
+}
+
+// RequestLogger returns a logger scoped to the request, it carries the route and operation
+// for the request when those are known
+func (c *Context) RequestLogger(request *http.Request, route *MatchedRoute) swag.Logger {
+	return c.Logger().WithFields(routeFields(request, route))
+}
+
+func (c *Context) logRequest(request *http.Request, route *MatchedRoute, rec *statusRecorder, start time.Time) {
+	c.RequestLogger(request, route).WithFields(swag.Fields{
+		"status":  rec.Status(),
+		"latency": time.Since(start),
+	}).Printf("handled request")
+}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -13,15 +14,15 @@ import (
 
 func TestOperationExecutor(t *testing.T) {
 	spec, api := petstore.NewAPI(t)
-	api.RegisterOperation("getAllPets", httpkit.OperationHandlerFunc(func(params interface{}) (interface{}, error) {
+	api.RegisterOperation("getAllPets", httpkit.OperationHandlerFunc(func(_ context.Context, params interface{}) (interface{}, error) {
 		return []interface{}{
 			map[string]interface{}{"id": 1, "name": "a dog"},
 		}, nil
 	}))
 
-	context := NewContext(spec, api, nil)
-	context.router = DefaultRouter(spec, context.api)
-	mw := newOperationExecutor(context)
+	ctx := NewContext(spec, api, nil)
+	ctx.router = DefaultRouter(spec, ctx.api)
+	mw := newOperationExecutor(ctx)
 
 	recorder := httptest.NewRecorder()
 	request, _ := http.NewRequest("GET", "/pets", nil)
@@ -32,13 +33,13 @@ func TestOperationExecutor(t *testing.T) {
 	assert.Equal(t, `[{"id":1,"name":"a dog"}]`+"\n", recorder.Body.String())
 
 	spec, api = petstore.NewAPI(t)
-	api.RegisterOperation("getAllPets", httpkit.OperationHandlerFunc(func(params interface{}) (interface{}, error) {
+	api.RegisterOperation("getAllPets", httpkit.OperationHandlerFunc(func(_ context.Context, params interface{}) (interface{}, error) {
 		return nil, errors.New(422, "expected")
 	}))
 
-	context = NewContext(spec, api, nil)
-	context.router = DefaultRouter(spec, context.api)
-	mw = newOperationExecutor(context)
+	ctx = NewContext(spec, api, nil)
+	ctx.router = DefaultRouter(spec, ctx.api)
+	mw = newOperationExecutor(ctx)
 
 	recorder = httptest.NewRecorder()
 	request, _ = http.NewRequest("GET", "/pets", nil)
@@ -46,5 +47,5 @@ func TestOperationExecutor(t *testing.T) {
 	request.SetBasicAuth("admin", "admin")
 	mw.ServeHTTP(recorder, request)
 	assert.Equal(t, 422, recorder.Code)
-	assert.Equal(t, `{"code":422,"message":"expected"}`, recorder.Body.String())
+	assert.Equal(t, `{"type":"about:blank","title":"Unprocessable Entity","status":422,"detail":"expected","instance":"/pets","code":422,"message":"expected"}`, recorder.Body.String())
 }
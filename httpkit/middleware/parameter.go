@@ -18,8 +18,6 @@ import (
 	"github.com/casualjim/go-swagger/swag"
 )
 
-const defaultMaxMemory = 32 << 20
-
 var textUnmarshalType = reflect.TypeOf(new(encoding.TextUnmarshaler)).Elem()
 
 func newUntypedParamBinder(param spec.Parameter, spec *spec.Swagger, formats strfmt.Registry) *untypedParamBinder {
@@ -168,15 +166,17 @@ func (p *untypedParamBinder) Bind(request *http.Request, routeParams RouteParams
 		}
 
 		if err != nil {
-			return errors.InvalidContentType("", []string{"multipart/form-data", "application/x-www-form-urlencoded"})
+			return errors.InvalidContentType("", []string{httpkit.MultipartFormMime, httpkit.URLencodedFormMime})
 		}
 
-		if mt != "multipart/form-data" && mt != "application/x-www-form-urlencoded" {
-			return errors.InvalidContentType(mt, []string{"multipart/form-data", "application/x-www-form-urlencoded"})
+		if mt != httpkit.MultipartFormMime && mt != httpkit.URLencodedFormMime {
+			return errors.InvalidContentType(mt, []string{httpkit.MultipartFormMime, httpkit.URLencodedFormMime})
 		}
 
-		if mt == "multipart/form-data" {
-			if err := request.ParseMultipartForm(defaultMaxMemory); err != nil {
+		// the context parses the form with the configured memory limit before binding,
+		// this only reads the body when the binder is used on its own
+		if mt == httpkit.MultipartFormMime {
+			if err := request.ParseMultipartForm(httpkit.DefaultMaxMemory); err != nil {
 				return errors.NewParseError(p.Name, p.parameter.In, "", err)
 			}
 		}
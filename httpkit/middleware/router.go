@@ -1,15 +1,16 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/casualjim/go-swagger/errors"
 	"github.com/casualjim/go-swagger/httpkit"
 	"github.com/casualjim/go-swagger/spec"
 	"github.com/casualjim/go-swagger/strfmt"
-	"github.com/gorilla/context"
 	"github.com/naoina/denco"
 )
 
@@ -40,30 +41,44 @@ func newRouter(ctx *Context, next http.Handler) http.Handler {
 	}
 	isRoot := ctx.spec.BasePath() == "" || ctx.spec.BasePath() == "/"
 
-	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
-		defer context.Clear(r)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the request scoped context gets cancelled when the client goes away
+		// or when the request has been handled
+		rctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		r = WithRequestScope(r.WithContext(rctx))
+		// the server only cleans up the forms of the request it created
+		defer removeMultipartForm(r)
+
+		start := time.Now()
+		w, rec := recordStatus(w)
+
 		// use context to lookup routes
 		if isRoot {
-			if _, ok := ctx.RouteInfo(r); ok {
-				next.ServeHTTP(rw, r)
+			if route, ok := ctx.RouteInfo(r); ok {
+				next.ServeHTTP(w, r)
+				ctx.logRequest(r, route, rec, start)
 				return
 			}
 		} else {
 			if p := strings.TrimPrefix(r.URL.Path, ctx.spec.BasePath()); len(p) < len(r.URL.Path) {
 				r.URL.Path = p
-				if _, ok := ctx.RouteInfo(r); ok {
-					next.ServeHTTP(rw, r)
+				if route, ok := ctx.RouteInfo(r); ok {
+					next.ServeHTTP(w, r)
+					ctx.logRequest(r, route, rec, start)
 					return
 				}
 			}
 		}
 		// Not found, check if it exists in the other methods first
 		if others := ctx.AllowedMethods(r); len(others) > 0 {
-			ctx.Respond(rw, r, ctx.spec.RequiredProduces(), nil, errors.MethodNotAllowed(r.Method, others))
+			ctx.Respond(w, r, ctx.spec.RequiredProduces(), nil, errors.MethodNotAllowed(r.Method, others))
+			ctx.logRequest(r, nil, rec, start)
 			return
 		}
 
-		ctx.Respond(rw, r, ctx.spec.RequiredProduces(), nil, errors.NotFound("path %s was not found", r.URL.Path))
+		ctx.Respond(w, r, ctx.spec.RequiredProduces(), nil, errors.NotFound("path %s was not found", r.URL.Path))
+		ctx.logRequest(r, nil, rec, start)
 	})
 }
 
@@ -183,6 +198,8 @@ func (d *defaultRouteBuilder) AddRoute(method, path string, operation *spec.Oper
 		definitions := d.spec.SecurityDefinitionsFor(operation)
 
 		record := denco.NewRecord(pathConverter.ReplaceAllString(path, ":$1"), &routeEntry{
+			PathPattern:    path,
+			BasePath:       d.spec.BasePath(),
 			Operation:      operation,
 			Handler:        handler,
 			Consumes:       consumes,
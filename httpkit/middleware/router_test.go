@@ -1,8 +1,15 @@
 package middleware
 
 import (
+	"bufio"
+	"bytes"
+	"context"
+	"io/ioutil"
+	"log"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"sort"
 	"strings"
 	"testing"
@@ -10,6 +17,7 @@ import (
 	"github.com/casualjim/go-swagger/httpkit/middleware/untyped"
 	"github.com/casualjim/go-swagger/internal/testing/petstore"
 	"github.com/casualjim/go-swagger/spec"
+	"github.com/casualjim/go-swagger/swag"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -134,3 +142,126 @@ func petAPIRouterBuilder(spec *spec.Document, api *untyped.API) *defaultRouteBui
 
 	return builder
 }
+
+func TestRouterLogsRequests(t *testing.T) {
+	spec, api := petstore.NewAPI(t)
+	context := NewContext(spec, api, nil)
+	var buf bytes.Buffer
+	context.SetLogger(swag.NewLogger(log.New(&buf, "", 0)))
+	mw := newRouter(context, http.HandlerFunc(terminator))
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/pets", nil)
+	mw.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	line := buf.String()
+	assert.Contains(t, line, "handled request")
+	assert.Contains(t, line, "operationId=getAllPets")
+	assert.Contains(t, line, "route=/pets")
+	assert.Contains(t, line, "status=200")
+	assert.Contains(t, line, "latency=")
+
+	buf.Reset()
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/nopets", nil)
+	mw.ServeHTTP(recorder, request)
+	assert.Contains(t, buf.String(), "status=404")
+	assert.NotContains(t, buf.String(), "operationId")
+}
+
+type hijackingRecorder struct {
+	*httptest.ResponseRecorder
+}
+
+func (h *hijackingRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, nil
+}
+
+func (h *hijackingRecorder) CloseNotify() <-chan bool {
+	return make(chan bool)
+}
+
+func TestRouterKeepsWriterInterfaces(t *testing.T) {
+	spec, api := petstore.NewAPI(t)
+	context := NewContext(spec, api, nil)
+
+	var flusher, hijacker, closeNotifier bool
+	mw := newRouter(context, http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		_, flusher = rw.(http.Flusher)
+		_, hijacker = rw.(http.Hijacker)
+		_, closeNotifier = rw.(http.CloseNotifier)
+		rw.WriteHeader(http.StatusAccepted)
+	}))
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/pets", nil)
+	mw.ServeHTTP(recorder, request)
+	assert.Equal(t, http.StatusAccepted, recorder.Code)
+	assert.True(t, flusher)
+	assert.False(t, hijacker)
+	assert.False(t, closeNotifier)
+
+	request, _ = http.NewRequest("GET", "/api/pets", nil)
+	mw.ServeHTTP(&hijackingRecorder{httptest.NewRecorder()}, request)
+	assert.True(t, flusher)
+	assert.True(t, hijacker)
+	assert.True(t, closeNotifier)
+}
+
+func TestRouterDoesntLogByDefault(t *testing.T) {
+	stderr, err := ioutil.TempFile("", "stderr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(stderr.Name())
+	defer func(original *os.File) { os.Stderr = original }(os.Stderr)
+	os.Stderr = stderr
+
+	spec, api := petstore.NewAPI(t)
+	mw := newRouter(NewContext(spec, api, nil), http.HandlerFunc(terminator))
+	request, _ := http.NewRequest("GET", "/api/pets", nil)
+	mw.ServeHTTP(httptest.NewRecorder(), request)
+
+	written, _ := ioutil.ReadFile(stderr.Name())
+	assert.Empty(t, string(written))
+}
+
+func TestRouterRequestContext(t *testing.T) {
+	spec, api := petstore.NewAPI(t)
+	ctx := NewContext(spec, api, nil)
+
+	var rctx context.Context
+	mw := newRouter(ctx, http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rctx = r.Context()
+		assert.NoError(t, rctx.Err())
+
+		route, ok := MatchedRouteFrom(rctx)
+		if assert.True(t, ok) {
+			assert.Equal(t, "getAllPets", route.Operation.ID)
+		}
+
+		_, ok = SecurityPrincipalFrom(rctx)
+		assert.False(t, ok)
+		route, _ = ctx.RouteInfo(r)
+		_, err := ctx.Authorize(r, route)
+		assert.NoError(t, err)
+		principal, ok := SecurityPrincipalFrom(rctx)
+		assert.True(t, ok)
+		assert.Equal(t, "admin", principal)
+
+		rw.WriteHeader(http.StatusOK)
+	}))
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/pets", nil)
+	request.SetBasicAuth("admin", "admin")
+	mw.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+
+	// the request scoped context is cancelled once the request has been handled
+	if assert.NotNil(t, rctx) {
+		assert.Equal(t, context.Canceled, rctx.Err())
+	}
+	_, ok := MatchedRouteFrom(request.Context())
+	assert.False(t, ok)
+}
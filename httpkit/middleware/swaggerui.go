@@ -0,0 +1,130 @@
+package middleware
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"net/http"
+	"path"
+
+	"github.com/casualjim/go-swagger/assets/swaggerui"
+	"github.com/casualjim/go-swagger/swag"
+	"github.com/elazarl/go-bindata-assetfs"
+)
+
+// SwaggerUIOpts configures the documentation page served by the SwaggerUI middleware
+type SwaggerUIOpts struct {
+	// BasePath the base path of the api, defaults to "/"
+	BasePath string
+	// Path the path of the documentation page relative to the base path, defaults to "docs"
+	Path string
+	// SpecURL the url of the spec document the page shows, defaults to "/swagger.json"
+	SpecURL string
+	// Assets the swagger-ui javascript and stylesheet bundled with go-bindata, they get served below
+	// the documentation page at "assets", defaults to the swagger-ui files embedded in this tool
+	Assets func() *assetfs.AssetFS
+	// AssetsURL overrides the url the swagger-ui javascript and stylesheet are loaded from,
+	// like SwaggerUICDN, the assets aren't served then
+	AssetsURL string
+	// Title the title of the page
+	Title string
+	// ReloadInterval when set the page checks the spec document every interval of this many
+	// milliseconds and reloads itself when the etag of the document changed
+	ReloadInterval int
+}
+
+// SwaggerUIVersion the version of the embedded swagger-ui files
+const SwaggerUIVersion = swaggerui.Version
+
+// SwaggerUICDN the url of the same version of swagger-ui on unpkg, for the AssetsURL option
+const SwaggerUICDN = "https://unpkg.com/swagger-ui-dist@" + SwaggerUIVersion
+
+// EnsureDefaults fills in the default values for the options that weren't set
+func (o *SwaggerUIOpts) EnsureDefaults() {
+	if o.BasePath == "" {
+		o.BasePath = "/"
+	}
+	if o.Path == "" {
+		o.Path = "docs"
+	}
+	if o.SpecURL == "" {
+		o.SpecURL = "/swagger.json"
+	}
+	if o.AssetsURL == "" {
+		if o.Assets == nil {
+			o.Assets = swaggerui.AssetFS
+		}
+		o.AssetsURL = path.Join(o.BasePath, o.Path, "assets")
+	}
+	if o.Title == "" {
+		o.Title = "API documentation"
+	}
+}
+
+// SwaggerUI creates a middleware that serves a documentation page for a spec document,
+// the page uses swagger-ui to render the spec document at the spec url
+func SwaggerUI(opts SwaggerUIOpts, next http.Handler) http.Handler {
+	served := opts.AssetsURL == ""
+	opts.EnsureDefaults()
+	pth := path.Join(opts.BasePath, opts.Path)
+	if served {
+		next = swag.MiddlewareAt(opts.AssetsURL+"/", opts.Assets, next)
+	}
+
+	var buf bytes.Buffer
+	if err := swaggerUITemplate.Execute(&buf, opts); err != nil {
+		panic(fmt.Errorf("cannot execute the swagger-ui template: %v", err))
+	}
+	page := buf.Bytes()
+
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == pth {
+			rw.Header().Set("Content-Type", "text/html; charset=utf-8")
+			rw.WriteHeader(http.StatusOK)
+			rw.Write(page)
+			return
+		}
+
+		if next == nil {
+			rw.Header().Set("Content-Type", "text/plain")
+			rw.WriteHeader(http.StatusNotFound)
+			rw.Write([]byte(fmt.Sprintf("%q not found", pth)))
+			return
+		}
+		next.ServeHTTP(rw, r)
+	})
+}
+
+var swaggerUITemplate = template.Must(template.New("swaggerui").Parse(`<!DOCTYPE html>
+<html>
+  <head>
+    <meta charset="utf-8">
+    <title>{{ .Title }}</title>
+    <link rel="stylesheet" href="{{ .AssetsURL }}/swagger-ui.css">
+  </head>
+  <body>
+    <div id="swagger-ui"></div>
+    <script src="{{ .AssetsURL }}/swagger-ui-bundle.js"></script>
+    <script>
+      window.ui = SwaggerUIBundle({ url: "{{ .SpecURL }}", dom_id: "#swagger-ui" });
+      {{ if .ReloadInterval }}
+      (function () {
+        var tag = null;
+        setInterval(function () {
+          var req = new XMLHttpRequest();
+          req.open("HEAD", "{{ .SpecURL }}");
+          req.onload = function () {
+            var current = req.getResponseHeader("ETag");
+            if (tag !== null && current !== tag) {
+              window.location.reload();
+            }
+            tag = current;
+          };
+          req.send();
+        }, {{ .ReloadInterval }});
+      })();
+      {{ end }}
+    </script>
+  </body>
+</html>
+`))
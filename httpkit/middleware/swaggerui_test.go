@@ -0,0 +1,105 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/elazarl/go-bindata-assetfs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSwaggerUIMiddleware(t *testing.T) {
+	handler := SwaggerUI(SwaggerUIOpts{BasePath: "/api", SpecURL: "/api/swagger.json", Title: "Petstore"}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/docs", nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, 200, recorder.Code)
+	assert.Equal(t, "text/html; charset=utf-8", recorder.Header().Get("Content-Type"))
+	assert.Contains(t, recorder.Body.String(), "<title>Petstore</title>")
+	assert.Contains(t, recorder.Body.String(), `url: "\/api\/swagger.json"`)
+	assert.Contains(t, recorder.Body.String(), `src="/api/docs/assets/swagger-ui-bundle.js"`)
+	assert.NotContains(t, recorder.Body.String(), SwaggerUICDN)
+	assert.NotContains(t, recorder.Body.String(), "window.location.reload")
+
+	// the embedded swagger-ui files are served
+	for _, name := range []string{"swagger-ui-bundle.js", "swagger-ui.css"} {
+		req, _ = http.NewRequest("GET", "/api/docs/assets/"+name, nil)
+		recorder = httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+		assert.Equal(t, 200, recorder.Code)
+		assert.NotEmpty(t, recorder.Body.String())
+	}
+	assert.Contains(t, recorder.Header().Get("Content-Type"), "text/css")
+
+	// returns 404 when no next handler
+	req, _ = http.NewRequest("GET", "/api/pets", nil)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, 404, recorder.Code)
+
+	// forwards to next handler for other url
+	handler = SwaggerUI(SwaggerUIOpts{ReloadInterval: 1000}, http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.WriteHeader(http.StatusOK)
+	}))
+	req, _ = http.NewRequest("GET", "/api/pets", nil)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, 200, recorder.Code)
+
+	req, _ = http.NewRequest("GET", "/docs", nil)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "window.location.reload")
+	assert.Contains(t, recorder.Body.String(), " 1000 ")
+}
+
+func TestSwaggerUIMiddleware_Assets(t *testing.T) {
+	assets := func() *assetfs.AssetFS {
+		return &assetfs.AssetFS{
+			Asset: func(name string) ([]byte, error) {
+				if name == "swagger-ui-bundle.js" {
+					return []byte("window.SwaggerUIBundle = function () {};"), nil
+				}
+				return nil, fmt.Errorf("Asset %s not found", name)
+			},
+			AssetDir: func(name string) ([]string, error) {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			},
+		}
+	}
+	handler := SwaggerUI(SwaggerUIOpts{BasePath: "/api", Assets: assets}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/docs", nil)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, 200, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), `src="/api/docs/assets/swagger-ui-bundle.js"`)
+	assert.NotContains(t, recorder.Body.String(), SwaggerUICDN)
+
+	req, _ = http.NewRequest("GET", "/api/docs/assets/swagger-ui-bundle.js", nil)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, 200, recorder.Code)
+	assert.Equal(t, "window.SwaggerUIBundle = function () {};", recorder.Body.String())
+
+	req, _ = http.NewRequest("GET", "/api/docs/assets/nope.js", nil)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, 404, recorder.Code)
+
+	// the assets url overrides the served assets
+	handler = SwaggerUI(SwaggerUIOpts{Assets: assets, AssetsURL: SwaggerUICDN}, nil)
+	req, _ = http.NewRequest("GET", "/docs", nil)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Contains(t, recorder.Body.String(), `src="https://unpkg.com/swagger-ui-dist@5.18.2/swagger-ui-bundle.js"`)
+
+	req, _ = http.NewRequest("GET", "/docs/assets/swagger-ui-bundle.js", nil)
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+	assert.Equal(t, 404, recorder.Code)
+}
@@ -1,7 +1,6 @@
 package untyped
 
 import (
-	"fmt"
 	"net/http"
 	"sort"
 	"strings"
@@ -161,7 +160,6 @@ func (d *API) validate() error {
 	if err := d.verify("auth scheme", authenticators, requiredAuths); err != nil {
 		return err
 	}
-	fmt.Printf("comparing %s with %s\n", strings.Join(definedAuths, ","), strings.Join(requiredAuths, ","))
 	if err := d.verify("security definitions", definedAuths, requiredAuths); err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package untyped
 
 import (
+	"context"
 	"io"
 	"sort"
 	"testing"
@@ -36,7 +37,7 @@ func (s *stubOperationHandler) ParameterModel() interface{} {
 	return nil
 }
 
-func (s *stubOperationHandler) Handle(params interface{}) (interface{}, error) {
+func (s *stubOperationHandler) Handle(ctx context.Context, params interface{}) (interface{}, error) {
 	return nil, nil
 }
 
@@ -240,10 +241,10 @@ func TestUntypedAppValidation(t *testing.T) {
 	authenticators := api3.AuthenticatorsFor(definitions)
 	assert.Len(t, authenticators, 1)
 
-	opHandler := httpkit.OperationHandlerFunc(func(data interface{}) (interface{}, error) {
+	opHandler := httpkit.OperationHandlerFunc(func(ctx context.Context, data interface{}) (interface{}, error) {
 		return data, nil
 	})
-	d, err := opHandler.Handle(1)
+	d, err := opHandler.Handle(context.Background(), 1)
 	assert.NoError(t, err)
 	assert.Equal(t, 1, d)
 
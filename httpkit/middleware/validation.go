@@ -92,7 +92,10 @@ func (v *validation) contentType() {
 			if err := validateContentType(v.route.Consumes, ct); err != nil {
 				v.result = append(v.result, err)
 			}
-			v.route.Consumer = v.route.Consumers[ct]
+			v.route.Consumer = v.context.consumerFor(v.route, v.request, ct)
+			if err := v.context.parseMultipartForm(v.request, v.route); err != nil {
+				v.result = append(v.result, err)
+			}
 		}
 	}
 }
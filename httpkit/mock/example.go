@@ -0,0 +1,205 @@
+package mock
+
+import (
+	"strings"
+
+	"github.com/casualjim/go-swagger/fake"
+	"github.com/casualjim/go-swagger/spec"
+)
+
+// maxDepth how deep the refs of a schema are followed, it stops recursive models
+const maxDepth = 8
+
+// Example builds an example value for the schema, the refs of the schema get resolved
+// against the definitions of the spec.
+//
+// The example, default or first enum value of a schema is used when there is one,
+// otherwise a value gets made up that matches the type, format and bounds of the schema.
+func Example(sp *spec.Swagger, schema *spec.Schema) interface{} {
+	ex := &exampler{spec: sp}
+	return ex.schema(schema, 0)
+}
+
+type exampler struct {
+	spec *spec.Swagger
+	// random when set generates random data for the responses without examples
+	random *fake.Generator
+}
+
+// response the body for the response, refs to the responses of the spec are resolved
+func (e *exampler) response(rsp *spec.Response) (interface{}, error) {
+	if ref := rsp.Ref.String(); strings.HasPrefix(ref, "#/responses/") {
+		if resolved, ok := e.spec.Responses[strings.TrimPrefix(ref, "#/responses/")]; ok {
+			rsp = &resolved
+		}
+	}
+
+	if examples, ok := rsp.Examples.(map[string]interface{}); ok && len(examples) > 0 {
+		keys := sortedKeys(examples)
+		for _, k := range keys {
+			if strings.Contains(strings.ToLower(k), "json") {
+				return examples[k], nil
+			}
+		}
+		return examples[keys[0]], nil
+	}
+	if rsp.Schema == nil {
+		return nil, nil
+	}
+	if e.random != nil {
+		return e.random.Schema(rsp.Schema)
+	}
+	return e.schema(rsp.Schema, 0), nil
+}
+
+func (e *exampler) resolve(schema *spec.Schema) *spec.Schema {
+	ref := schema.Ref.String()
+	if !strings.HasPrefix(ref, "#/definitions/") {
+		return schema
+	}
+	if resolved, ok := e.spec.Definitions[strings.TrimPrefix(ref, "#/definitions/")]; ok {
+		return &resolved
+	}
+	return schema
+}
+
+func (e *exampler) schema(schema *spec.Schema, depth int) interface{} {
+	if schema == nil || depth > maxDepth {
+		return nil
+	}
+	if schema.Ref.String() != "" {
+		return e.schema(e.resolve(schema), depth+1)
+	}
+
+	switch {
+	case schema.Example != nil:
+		return schema.Example
+	case schema.Default != nil:
+		return schema.Default
+	case len(schema.Enum) > 0:
+		return schema.Enum[0]
+	}
+
+	if len(schema.AllOf) > 0 {
+		result := make(map[string]interface{})
+		for i := range schema.AllOf {
+			if part, ok := e.schema(&schema.AllOf[i], depth+1).(map[string]interface{}); ok {
+				for k, v := range part {
+					result[k] = v
+				}
+			}
+		}
+		for k, v := range e.object(schema, depth) {
+			result[k] = v
+		}
+		return result
+	}
+
+	tpe := ""
+	if len(schema.Type) > 0 {
+		tpe = schema.Type[0]
+	} else if len(schema.Properties) > 0 {
+		tpe = "object"
+	}
+
+	switch tpe {
+	case "object":
+		return e.object(schema, depth)
+	case "array":
+		var item interface{}
+		if schema.Items != nil && schema.Items.Schema != nil {
+			item = e.schema(schema.Items.Schema, depth+1)
+		}
+		size := 1
+		if schema.MinItems != nil && *schema.MinItems > 1 {
+			size = int(*schema.MinItems)
+		}
+		if schema.MaxItems != nil && *schema.MaxItems < int64(size) {
+			size = int(*schema.MaxItems)
+		}
+		result := make([]interface{}, size)
+		for i := range result {
+			result[i] = item
+		}
+		return result
+	case "string":
+		return exampleString(schema)
+	case "integer":
+		return int64(exampleNumber(schema, 1))
+	case "number":
+		if schema.Format == "int32" || schema.Format == "int64" {
+			return int64(exampleNumber(schema, 1))
+		}
+		return exampleNumber(schema, 0.5)
+	case "boolean":
+		return true
+	}
+	return nil
+}
+
+func (e *exampler) object(schema *spec.Schema, depth int) map[string]interface{} {
+	result := make(map[string]interface{})
+	for name, prop := range schema.Properties {
+		prop := prop
+		result[name] = e.schema(&prop, depth+1)
+	}
+	if len(schema.Properties) == 0 && schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+		result["additionalProp"] = e.schema(schema.AdditionalProperties.Schema, depth+1)
+	}
+	return result
+}
+
+var formatExamples = map[string]string{
+	"date":         "2015-10-21",
+	"date-time":    "2015-10-21T16:29:00.000Z",
+	"uuid":         "3fa85f64-5717-4562-b3fc-2c963f66afa6",
+	"email":        "user@example.com",
+	"uri":          "http://example.com",
+	"hostname":     "example.com",
+	"ipv4":         "192.168.0.1",
+	"ipv6":         "::1",
+	"byte":         "ZXhhbXBsZQ==",
+	"password":     "password",
+	"duration":     "1h",
+	"isbn":         "0321751043",
+	"creditcard":   "4111111111111111",
+	"hexcolor":     "#ffffff",
+	"rgbcolor":     "rgb(255,255,255)",
+	"mac":          "01:23:45:67:89:ab",
+	"bsonobjectid": "507f1f77bcf86cd799439011",
+}
+
+func exampleString(schema *spec.Schema) string {
+	if value, ok := formatExamples[schema.Format]; ok {
+		return value
+	}
+	value := "string"
+	for schema.MinLength != nil && int64(len(value)) < *schema.MinLength {
+		value += "string"
+	}
+	if schema.MaxLength != nil && int64(len(value)) > *schema.MaxLength {
+		value = value[:*schema.MaxLength]
+	}
+	return value
+}
+
+// exampleNumber a number within the bounds of the schema, step is how far
+// it stays from an exclusive bound
+func exampleNumber(schema *spec.Schema, step float64) float64 {
+	switch {
+	case schema.Minimum != nil:
+		if schema.ExclusiveMinimum {
+			return *schema.Minimum + step
+		}
+		return *schema.Minimum
+	case schema.Maximum != nil && *schema.Maximum <= 0:
+		if schema.ExclusiveMaximum {
+			return *schema.Maximum - step
+		}
+		return *schema.Maximum
+	}
+	if schema.MultipleOf != nil {
+		return *schema.MultipleOf
+	}
+	return step
+}
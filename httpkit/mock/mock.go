@@ -0,0 +1,179 @@
+// Package mock serves a swagger spec without an implementation of its operations.
+//
+// Every operation responds with its success response, the body is the example of the response
+// for the media type or, when there is none, an example built from the schema of the response.
+// The random variants generate random data for the schema of the response instead.
+// The requests are still bound and validated against the spec, and secured operations still need
+// credentials, although any credentials are accepted.
+package mock
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/casualjim/go-swagger/errors"
+	"github.com/casualjim/go-swagger/fake"
+	"github.com/casualjim/go-swagger/httpkit"
+	"github.com/casualjim/go-swagger/httpkit/middleware"
+	"github.com/casualjim/go-swagger/httpkit/middleware/untyped"
+	"github.com/casualjim/go-swagger/httpkit/security"
+	"github.com/casualjim/go-swagger/spec"
+)
+
+// Serve creates a http handler that serves the mocked operations of the spec document
+func Serve(doc *spec.Document) (http.Handler, error) {
+	return serve(doc, NewAPI(doc))
+}
+
+// ServeRandom creates a http handler that serves the mocked operations of the spec document,
+// the responses without examples get random data, the same seed gives the same data
+func ServeRandom(doc *spec.Document, seed int64) (http.Handler, error) {
+	return serve(doc, NewRandomAPI(doc, seed))
+}
+
+func serve(doc *spec.Document, api *untyped.API) (http.Handler, error) {
+	if err := api.Validate(); err != nil {
+		return nil, err
+	}
+	return middleware.Serve(doc, api), nil
+}
+
+// NewAPI creates an untyped api for the spec document, with a mock handler for every operation,
+// the consumers and producers for the media types of the spec and authenticators for the
+// security schemes that are used
+func NewAPI(doc *spec.Document) *untyped.API {
+	return newAPI(doc, &exampler{spec: doc.Spec()})
+}
+
+// NewRandomAPI creates an untyped api for the spec document like NewAPI, but the responses
+// without examples get random data generated for their schema
+func NewRandomAPI(doc *spec.Document, seed int64) *untyped.API {
+	return newAPI(doc, &exampler{spec: doc.Spec(), random: fake.New(doc.Spec(), nil, seed)})
+}
+
+func newAPI(doc *spec.Document, ex *exampler) *untyped.API {
+	api := untyped.NewAPI(doc)
+	for _, mt := range doc.RequiredConsumes() {
+		api.RegisterConsumer(mt, consumerFor(mt))
+	}
+	for _, mt := range doc.RequiredProduces() {
+		api.RegisterProducer(mt, producerFor(mt))
+	}
+	for _, name := range doc.RequiredSchemes() {
+		if scheme, ok := doc.Spec().SecurityDefinitions[name]; ok {
+			api.RegisterAuth(name, authenticatorFor(scheme))
+		}
+	}
+
+	for _, id := range doc.OperationIDs() {
+		op, _ := doc.OperationForName(id)
+		api.RegisterOperation(id, operationHandler(ex, op))
+	}
+	return api
+}
+
+func operationHandler(ex *exampler, op *spec.Operation) httpkit.OperationHandler {
+	return httpkit.OperationHandlerFunc(func(ctx context.Context, params interface{}) (interface{}, error) {
+		rsp, code, ok := op.SuccessResponse()
+		if !ok {
+			return nil, errors.New(http.StatusNotImplemented, "the operation %q has no success response to mock", op.ID)
+		}
+		body, err := ex.response(rsp)
+		if err != nil {
+			return nil, err
+		}
+		return responder(code, body), nil
+	})
+}
+
+// responder writes the status code of the success response and the body, the api leaves
+// out the body of some status codes, like 201, that do have a schema in the spec
+func responder(code int, body interface{}) httpkit.Responder {
+	return httpkit.ResponderFunc(func(rw http.ResponseWriter, producer httpkit.Producer) {
+		if body == nil || code == http.StatusNoContent {
+			rw.WriteHeader(code)
+			return
+		}
+		if producer == nil {
+			rw.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		rw.WriteHeader(code)
+		if err := producer.Produce(rw, body); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	})
+}
+
+func consumerFor(mediaType string) httpkit.Consumer {
+	mt := strings.ToLower(mediaType)
+	switch {
+	case strings.Contains(mt, "json"):
+		return httpkit.JSONConsumer()
+	case strings.Contains(mt, "xml"):
+		return httpkit.XMLConsumer()
+	case strings.Contains(mt, "yaml"):
+		return httpkit.YAMLConsumer()
+	case strings.HasPrefix(mt, httpkit.URLencodedFormMime):
+		return httpkit.FormConsumer()
+	case strings.HasPrefix(mt, httpkit.MultipartFormMime):
+		return httpkit.MultipartConsumer()
+	case strings.HasPrefix(mt, "text/"):
+		return httpkit.TextConsumer()
+	}
+	return httpkit.ByteStreamConsumer()
+}
+
+func producerFor(mediaType string) httpkit.Producer {
+	mt := strings.ToLower(mediaType)
+	switch {
+	case strings.Contains(mt, "json"):
+		return httpkit.JSONProducer()
+	case strings.Contains(mt, "xml"):
+		return httpkit.XMLProducer()
+	case strings.Contains(mt, "yaml"):
+		return httpkit.YAMLProducer()
+	case strings.HasPrefix(mt, httpkit.URLencodedFormMime):
+		return httpkit.FormProducer()
+	case strings.HasPrefix(mt, "text/"):
+		return httpkit.TextProducer()
+	}
+	return httpkit.ByteStreamProducer()
+}
+
+// authenticatorFor an authenticator that accepts any credentials for the scheme,
+// the principal is the user name or the token
+func authenticatorFor(scheme *spec.SecurityScheme) httpkit.Authenticator {
+	accept := func(token string) (interface{}, error) { return token, nil }
+	switch scheme.Type {
+	case "basic":
+		return security.BasicAuth(func(user, _ string) (interface{}, error) { return user, nil })
+	case "apiKey":
+		return security.APIKeyAuth(scheme.Name, scheme.In, accept)
+	}
+	// oauth2 needs a bearer token
+	return httpkit.AuthenticatorFunc(func(params interface{}) (bool, interface{}, error) {
+		r, ok := params.(*http.Request)
+		if !ok {
+			return false, nil, nil
+		}
+		auth := r.Header.Get("Authorization")
+		if !strings.HasPrefix(auth, "Bearer ") {
+			return false, nil, nil
+		}
+		p, err := accept(strings.TrimPrefix(auth, "Bearer "))
+		return true, p, err
+	})
+}
+
+// sortedKeys the keys of the examples of a response
+func sortedKeys(examples map[string]interface{}) []string {
+	var keys []string
+	for k := range examples {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,139 @@
+package mock
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/casualjim/go-swagger/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockHandler(t testing.TB) http.Handler {
+	doc, err := spec.Load("../../fixtures/mock/petstore.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler, err := Serve(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return handler
+}
+
+func serveMock(handler http.Handler, method, path, body string, headers map[string]string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Accept", "application/json")
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestServe_Example(t *testing.T) {
+	rec := serveMock(mockHandler(t), "GET", "/api/pets", "", nil)
+	assert.Equal(t, 200, rec.Code)
+	assert.JSONEq(t, `[{"id": 1, "name": "Rex", "tag": "dog"}]`, rec.Body.String())
+}
+
+func TestServe_SchemaExample(t *testing.T) {
+	rec := serveMock(mockHandler(t), "GET", "/api/pets/12", "", nil)
+	assert.Equal(t, 200, rec.Code)
+
+	var pet map[string]interface{}
+	if assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &pet)) {
+		assert.EqualValues(t, 1, pet["id"])
+		assert.Equal(t, "stringstring", pet["name"])
+		assert.Equal(t, "available", pet["status"])
+		assert.Equal(t, "cat", pet["tag"])
+		assert.Equal(t, "2015-10-21", pet["birthday"])
+		assert.Equal(t, map[string]interface{}{"email": "user@example.com"}, pet["owner"])
+	}
+}
+
+func TestServeRandom(t *testing.T) {
+	doc, err := spec.Load("../../fixtures/mock/petstore.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	random := func() string {
+		handler, err := ServeRandom(doc, 42)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rec := serveMock(handler, "GET", "/api/pets/12", "", nil)
+		assert.Equal(t, 200, rec.Code)
+		return rec.Body.String()
+	}
+
+	body := random()
+	var pet map[string]interface{}
+	if assert.NoError(t, json.Unmarshal([]byte(body), &pet)) {
+		assert.True(t, len(pet["name"].(string)) >= 10)
+		assert.NotEqual(t, "stringstring", pet["name"])
+	}
+	assert.Equal(t, body, random())
+
+	// the examples of the responses are still used
+	handler, _ := ServeRandom(doc, 42)
+	rec := serveMock(handler, "GET", "/api/pets", "", nil)
+	assert.JSONEq(t, `[{"id": 1, "name": "Rex", "tag": "dog"}]`, rec.Body.String())
+}
+
+func TestServe_ValidatesRequests(t *testing.T) {
+	handler := mockHandler(t)
+
+	rec := serveMock(handler, "GET", "/api/pets?limit=1000", "", nil)
+	assert.Equal(t, 422, rec.Code)
+
+	rec = serveMock(handler, "GET", "/api/pets/abc", "", nil)
+	assert.Equal(t, 422, rec.Code)
+
+	rec = serveMock(handler, "POST", "/api/pets", `{"name": "Rex"}`, nil)
+	assert.Equal(t, 401, rec.Code)
+
+	rec = serveMock(handler, "POST", "/api/pets", `{"id": 2}`, map[string]string{"X-API-Key": "anything"})
+	assert.Equal(t, 422, rec.Code)
+
+	rec = serveMock(handler, "POST", "/api/pets", `{"name": "Rex the dog"}`, map[string]string{"X-API-Key": "anything"})
+	assert.Equal(t, 201, rec.Code)
+}
+
+func TestServe_Created(t *testing.T) {
+	rec := serveMock(mockHandler(t), "POST", "/api/pets", `{"name": "Rex the dog"}`, map[string]string{"X-API-Key": "anything"})
+	assert.Equal(t, 201, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var pet map[string]interface{}
+	if assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &pet)) {
+		assert.Equal(t, "stringstring", pet["name"])
+	}
+}
+
+func TestServe_NoSuccessResponse(t *testing.T) {
+	rec := serveMock(mockHandler(t), "DELETE", "/api/pets/12", "", nil)
+	assert.Equal(t, 501, rec.Code)
+}
+
+func TestExample(t *testing.T) {
+	sp := new(spec.Swagger)
+	sp.Definitions = spec.Definitions{"tag": *spec.StringProperty().WithMaxLength(3)}
+
+	assert.Equal(t, "str", Example(sp, spec.RefProperty("#/definitions/tag")))
+	assert.Equal(t, []interface{}{int64(5), int64(5)}, Example(sp, spec.ArrayProperty(spec.Int64Property().WithMinimum(4, true)).WithMinItems(2)))
+	assert.Equal(t, -1.0, Example(sp, spec.Float64Property().WithMaximum(-1, false)))
+	assert.Equal(t, true, Example(sp, spec.BoolProperty()))
+	assert.Equal(t, map[string]interface{}{"additionalProp": "str"}, Example(sp, spec.MapProperty(spec.RefProperty("#/definitions/tag"))))
+	assert.Nil(t, Example(sp, nil))
+
+	// recursive models stop at some depth
+	sp.Definitions["node"] = *new(spec.Schema).Typed("object", "").SetProperty("next", *spec.RefProperty("#/definitions/node"))
+	assert.NotNil(t, Example(sp, spec.RefProperty("#/definitions/node")))
+}
@@ -0,0 +1,68 @@
+package httpkit
+
+import (
+	"encoding"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// TextConsumer creates a new text consumer, it reads the body into a *string, a *[]byte,
+// an io.Writer or an encoding.TextUnmarshaler
+func TextConsumer() Consumer {
+	return ConsumerFunc(func(reader io.Reader, data interface{}) error {
+		if w, ok := data.(io.Writer); ok {
+			_, err := io.Copy(w, reader)
+			return err
+		}
+
+		b, err := ioutil.ReadAll(reader)
+		if err != nil {
+			return err
+		}
+
+		switch t := data.(type) {
+		case *string:
+			*t = string(b)
+		case *[]byte:
+			*t = b
+		case encoding.TextUnmarshaler:
+			return t.UnmarshalText(b)
+		default:
+			return fmt.Errorf("%T is not supported by the text consumer", data)
+		}
+		return nil
+	})
+}
+
+// TextProducer creates a new text producer, it writes strings, byte slices, io.Readers,
+// encoding.TextMarshalers, fmt.Stringers and errors
+func TextProducer() Producer {
+	return ProducerFunc(func(writer io.Writer, data interface{}) error {
+		switch t := data.(type) {
+		case string:
+			_, err := io.WriteString(writer, t)
+			return err
+		case []byte:
+			_, err := writer.Write(t)
+			return err
+		case io.Reader:
+			_, err := io.Copy(writer, t)
+			return err
+		case encoding.TextMarshaler:
+			b, err := t.MarshalText()
+			if err != nil {
+				return err
+			}
+			_, err = writer.Write(b)
+			return err
+		case fmt.Stringer:
+			_, err := io.WriteString(writer, t.String())
+			return err
+		case error:
+			_, err := io.WriteString(writer, t.Error())
+			return err
+		}
+		return fmt.Errorf("%T is not supported by the text producer", data)
+	})
+}
@@ -0,0 +1,46 @@
+package httpkit
+
+import (
+	"bytes"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var consProdText = `The quick brown fox jumped over the lazy dog.`
+
+func TestTextConsumer(t *testing.T) {
+	cons := TextConsumer()
+
+	var str string
+	err := cons.Consume(bytes.NewBuffer([]byte(consProdText)), &str)
+	assert.NoError(t, err)
+	assert.Equal(t, consProdText, str)
+
+	var buf bytes.Buffer
+	err = cons.Consume(bytes.NewBuffer([]byte(consProdText)), &buf)
+	assert.NoError(t, err)
+	assert.Equal(t, consProdText, buf.String())
+
+	var num int
+	assert.Error(t, cons.Consume(bytes.NewBuffer([]byte(consProdText)), &num))
+}
+
+func TestTextProducer(t *testing.T) {
+	prod := TextProducer()
+
+	rw := httptest.NewRecorder()
+	err := prod.Produce(rw, consProdText)
+	assert.NoError(t, err)
+	assert.Equal(t, consProdText, rw.Body.String())
+
+	rw = httptest.NewRecorder()
+	err = prod.Produce(rw, errors.New(consProdText))
+	assert.NoError(t, err)
+	assert.Equal(t, consProdText, rw.Body.String())
+
+	rw = httptest.NewRecorder()
+	assert.Error(t, prod.Produce(rw, 1))
+}
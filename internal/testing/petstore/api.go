@@ -1,6 +1,7 @@
 package petstore
 
 import (
+	"context"
 	"io"
 	gotest "testing"
 
@@ -127,6 +128,6 @@ func (s *stubOperationHandler) ParameterModel() interface{} {
 	return nil
 }
 
-func (s *stubOperationHandler) Handle(params interface{}) (interface{}, error) {
+func (s *stubOperationHandler) Handle(ctx context.Context, params interface{}) (interface{}, error) {
 	return nil, nil
 }
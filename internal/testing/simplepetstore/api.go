@@ -1,6 +1,7 @@
 package simplepetstore
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"sync"
@@ -29,11 +30,11 @@ func NewPetstore() (http.Handler, error) {
 	return middleware.Serve(spec, api), nil
 }
 
-var getAllPets = httpkit.OperationHandlerFunc(func(data interface{}) (interface{}, error) {
+var getAllPets = httpkit.OperationHandlerFunc(func(ctx context.Context, data interface{}) (interface{}, error) {
 	return pets, nil
 })
 
-var createPet = httpkit.OperationHandlerFunc(func(data interface{}) (interface{}, error) {
+var createPet = httpkit.OperationHandlerFunc(func(ctx context.Context, data interface{}) (interface{}, error) {
 	body := data.(map[string]interface{})["pet"].(map[string]interface{})
 	return addPet(Pet{
 		Name:   body["name"].(string),
@@ -41,13 +42,13 @@ var createPet = httpkit.OperationHandlerFunc(func(data interface{}) (interface{}
 	}), nil
 })
 
-var deletePet = httpkit.OperationHandlerFunc(func(data interface{}) (interface{}, error) {
+var deletePet = httpkit.OperationHandlerFunc(func(ctx context.Context, data interface{}) (interface{}, error) {
 	id := data.(map[string]interface{})["id"].(int64)
 	removePet(id)
 	return nil, nil
 })
 
-var getPetByID = httpkit.OperationHandlerFunc(func(data interface{}) (interface{}, error) {
+var getPetByID = httpkit.OperationHandlerFunc(func(ctx context.Context, data interface{}) (interface{}, error) {
 	id := data.(map[string]interface{})["id"].(int64)
 	return petByID(id)
 })
@@ -63,5 +63,5 @@ func TestSimplePetstoreDeletePet(t *testing.T) {
 	rw = httptest.NewRecorder()
 	handler.ServeHTTP(rw, r)
 	assert.Equal(t, 404, rw.Code)
-	assert.Equal(t, "{\"code\":404,\"message\":\"not found: pet 1\"}", rw.Body.String())
+	assert.Equal(t, `{"type":"about:blank","title":"Not Found","status":404,"detail":"not found: pet 1","instance":"/pets/1","code":404,"message":"not found: pet 1"}`, rw.Body.String())
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/casualjim/go-swagger/internal/testing/petstore"
 	"github.com/casualjim/go-swagger/spec"
+	"github.com/casualjim/go-swagger/strfmt"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -218,3 +219,27 @@ func TestValidateReferencesValid(t *testing.T) {
 
 func TestValidateDefaultValueAgainstSchema(t *testing.T) {
 }
+
+func TestValidateHost(t *testing.T) {
+	validator := NewSpecValidator(spec.MustLoadSwagger20Schema(), strfmt.Default)
+	for host, valid := range map[string]bool{
+		"petstore.swagger.wordnik.com": true,
+		"localhost:8080":               true,
+		"127.0.0.1":                    true,
+		"http://localhost":             false,
+		"localhost/api":                false,
+	} {
+		doc, _ := petstore.NewAPI(t)
+		doc.Spec().Host = host
+		b, err := doc.Spec().MarshalJSON()
+		if !assert.NoError(t, err) {
+			return
+		}
+		doc, err = spec.New(b, "")
+		if !assert.NoError(t, err) {
+			return
+		}
+		errs, _ := validator.Validate(doc)
+		assert.Equal(t, valid, errs.IsValid(), host)
+	}
+}
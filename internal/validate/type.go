@@ -28,6 +28,10 @@ var jsonTypeNames = map[string]struct{}{
 	"string":  struct{}{},
 }
 
+var jsonFloatFormats = map[string]bool{"float": true, "double": true, "float32": true}
+
+var jsonIntFormats = map[string]bool{"int8": true, "int16": true, "int32": true, "int64": true}
+
 func (t *typeValidator) schemaInfoForType(data interface{}) (string, string) {
 	switch data.(type) {
 	case []byte:
@@ -126,8 +130,10 @@ func (t *typeValidator) Validate(data interface{}) *Result {
 	schType, format := t.schemaInfoForType(data)
 	isLowerInt := t.Format == "int64" && format == "int32"
 	isLowerFloat := t.Format == "float64" && format == "float32"
+	// a json number decodes as a float64, so it can be any of the number formats
+	isJSONNumber := format == "float64" && (jsonFloatFormats[t.Format] || (jsonIntFormats[t.Format] && swag.IsFloat64AJSONInteger(val.Float())))
 
-	if val.Kind() != reflect.String && t.Format != "" && !(format == t.Format || isLowerInt || isLowerFloat) {
+	if val.Kind() != reflect.String && t.Format != "" && !(format == t.Format || isLowerInt || isLowerFloat || isJSONNumber) {
 		return sErr(errors.InvalidType(t.Path, t.In, t.Format, format))
 	}
 	if t.Format != "" && val.Kind() == reflect.String {
@@ -160,3 +160,45 @@ func TestJSONSchemaSuite(t *testing.T) {
 		}
 	})
 }
+
+func TestTypeValidator_JSONNumbers(t *testing.T) {
+	Convey("A number decoded from json", t, func() {
+		Convey("is valid for the number formats", func() {
+			for _, format := range []string{"float", "double"} {
+				validator := &typeValidator{Type: spec.StringOrArray{"number"}, Format: format}
+				So(validator.Validate(1.5).HasErrors(), ShouldBeFalse)
+			}
+		})
+		Convey("is valid for the integer formats when it has no fraction", func() {
+			for _, format := range []string{"int32", "int64"} {
+				validator := &typeValidator{Type: spec.StringOrArray{"integer"}, Format: format}
+				So(validator.Validate(float64(12)).HasErrors(), ShouldBeFalse)
+				So(validator.Validate(12.5).HasErrors(), ShouldBeTrue)
+			}
+		})
+	})
+}
+
+func TestSchemaValidator_JSONNumbers(t *testing.T) {
+	Convey("A json document validated against a schema with number formats", t, func() {
+		schema := new(spec.Schema).Typed("object", "").
+			SetProperty("id", *spec.Int64Property()).
+			SetProperty("count", *spec.Int32Property()).
+			SetProperty("price", *spec.Float32Property()).
+			SetProperty("total", *spec.Float64Property())
+		validate := func(doc string) *Result {
+			var data interface{}
+			So(json.Unmarshal([]byte(doc), &data), ShouldBeNil)
+			return NewSchemaValidator(schema, nil, "body", strfmt.Default).Validate(data)
+		}
+
+		Convey("is valid when the numbers match the formats", func() {
+			So(validate(`{"id": 12, "count": 3, "price": 1.5, "total": 1e3}`).IsValid(), ShouldBeTrue)
+			So(validate(`{"price": 2, "total": 3}`).IsValid(), ShouldBeTrue)
+		})
+		Convey("is invalid when an integer has a fraction", func() {
+			So(validate(`{"id": 12.5}`).IsValid(), ShouldBeFalse)
+			So(validate(`{"count": 0.1}`).IsValid(), ShouldBeFalse)
+		})
+	})
+}
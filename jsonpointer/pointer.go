@@ -26,6 +26,7 @@
 package jsonpointer
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
@@ -43,6 +44,7 @@ const (
 )
 
 var jsonPointableType = reflect.TypeOf(new(JSONPointable)).Elem()
+var jsonSetableType = reflect.TypeOf(new(JSONSetable)).Elem()
 
 // JSONPointable is an interface for structs to implement when they need to customize the
 // json pointer process
@@ -50,6 +52,14 @@ type JSONPointable interface {
 	JSONLookup(string) (interface{}, error)
 }
 
+// JSONSetable is an interface for structs to implement when they need to customize the
+// json pointer process for setting a value. It's used for the tokens that aren't fields of the
+// struct, a nil value removes the token. To set a value below such a token the struct also
+// needs to be JSONPointable: the value for the token is looked up, changed and then set again
+type JSONSetable interface {
+	JSONSet(string, interface{}) error
+}
+
 type implStruct struct {
 	mode string // "SET" or "GET"
 
@@ -192,6 +202,252 @@ func (p *Pointer) get(node interface{}, nameProvider *swag.NameProvider) (interf
 	return node, kind, nil
 }
 
+// Set uses the pointer to set a value in a JSON document.
+// It returns the document, which is a different value than the one passed in when the pointer
+// is empty or when the document isn't a pointer and had to be copied to change it.
+// The last token of the pointer can be "-" to append the value to an array.
+func (p *Pointer) Set(document, value interface{}) (interface{}, error) {
+	return p.set(document, value, swag.DefaultJSONNameProvider)
+}
+
+// Delete uses the pointer to remove a value from a JSON document, fields of structs are set to their zero value.
+// It returns the document, which is a different value than the one passed in when the document
+// isn't a pointer and had to be copied to change it.
+func (p *Pointer) Delete(document interface{}) (interface{}, error) {
+	return p.delete(document, swag.DefaultJSONNameProvider)
+}
+
+func (p *Pointer) set(node, value interface{}, nameProvider *swag.NameProvider) (interface{}, error) {
+	if nameProvider == nil {
+		nameProvider = swag.DefaultJSONNameProvider
+	}
+
+	// Full document when empty
+	if len(p.referenceTokens) == 0 {
+		return value, nil
+	}
+
+	result, err := setImpl(reflect.ValueOf(node), p.DecodedTokens(), value, nameProvider)
+	if err != nil {
+		return nil, err
+	}
+	return result.Interface(), nil
+}
+
+func (p *Pointer) delete(node interface{}, nameProvider *swag.NameProvider) (interface{}, error) {
+	if nameProvider == nil {
+		nameProvider = swag.DefaultJSONNameProvider
+	}
+
+	if len(p.referenceTokens) == 0 {
+		return nil, errors.New("can't delete the full document")
+	}
+
+	result, err := setImpl(reflect.ValueOf(node), p.DecodedTokens(), deleteValue, nameProvider)
+	if err != nil {
+		return nil, err
+	}
+	return result.Interface(), nil
+}
+
+// deleteValue a marker value for setImpl to remove the value at the pointer instead of setting it
+var deleteValue = &struct{ delete bool }{true}
+
+// setImpl sets the value for the tokens in the node and returns the node with the change,
+// when the node can't be changed in place the returned node is a changed copy
+func setImpl(node reflect.Value, tokens []string, value interface{}, nameProvider *swag.NameProvider) (reflect.Value, error) {
+	decodedToken := tokens[0]
+	last := len(tokens) == 1
+
+	switch node.Kind() {
+	case reflect.Interface:
+		if node.IsNil() {
+			return node, fmt.Errorf("invalid token reference %q", decodedToken)
+		}
+		return setImpl(node.Elem(), tokens, value, nameProvider)
+
+	case reflect.Ptr:
+		if node.IsNil() {
+			return node, fmt.Errorf("invalid token reference %q", decodedToken)
+		}
+		elem, err := setImpl(node.Elem(), tokens, value, nameProvider)
+		if err != nil {
+			return node, err
+		}
+		node.Elem().Set(elem)
+		return node, nil
+
+	case reflect.Struct:
+		if !node.CanSet() {
+			cp := reflect.New(node.Type()).Elem()
+			cp.Set(node)
+			node = cp
+		}
+		nm, ok := nameProvider.GetGoNameForType(node.Type(), decodedToken)
+		if !ok {
+			return setCustom(node, tokens, value, nameProvider)
+		}
+		fld := node.FieldByName(nm)
+		if !fld.CanSet() {
+			return node, fmt.Errorf("field %q can't be set", decodedToken)
+		}
+		if last {
+			if value == deleteValue {
+				fld.Set(reflect.Zero(fld.Type()))
+				return node, nil
+			}
+			val, err := valueFor(value, fld.Type())
+			if err != nil {
+				return node, err
+			}
+			fld.Set(val)
+			return node, nil
+		}
+		val, err := setImpl(fld, tokens[1:], value, nameProvider)
+		if err != nil {
+			return node, err
+		}
+		fld.Set(val)
+		return node, nil
+
+	case reflect.Map:
+		if node.Type().Key().Kind() != reflect.String {
+			return node, fmt.Errorf("invalid token reference %q", decodedToken)
+		}
+		kv := reflect.ValueOf(decodedToken).Convert(node.Type().Key())
+		if last {
+			if value == deleteValue {
+				if !node.MapIndex(kv).IsValid() {
+					return node, fmt.Errorf("object has no key %q", decodedToken)
+				}
+				node.SetMapIndex(kv, reflect.Value{})
+				return node, nil
+			}
+			val, err := valueFor(value, node.Type().Elem())
+			if err != nil {
+				return node, err
+			}
+			if node.IsNil() {
+				node = reflect.MakeMap(node.Type())
+			}
+			node.SetMapIndex(kv, val)
+			return node, nil
+		}
+		mv := node.MapIndex(kv)
+		if !mv.IsValid() {
+			return node, fmt.Errorf("object has no key %q", decodedToken)
+		}
+		val, err := setImpl(mv, tokens[1:], value, nameProvider)
+		if err != nil {
+			return node, err
+		}
+		node.SetMapIndex(kv, val)
+		return node, nil
+
+	case reflect.Slice:
+		if last && decodedToken == "-" && value != deleteValue {
+			val, err := valueFor(value, node.Type().Elem())
+			if err != nil {
+				return node, err
+			}
+			return reflect.Append(node, val), nil
+		}
+		tokenIndex, err := strconv.Atoi(decodedToken)
+		if err != nil {
+			return node, err
+		}
+		sLength := node.Len()
+		if tokenIndex < 0 || tokenIndex >= sLength {
+			return node, fmt.Errorf("index out of bounds array[0,%d] index '%d'", sLength, tokenIndex)
+		}
+		if last && value == deleteValue {
+			result := reflect.MakeSlice(node.Type(), 0, sLength-1)
+			result = reflect.AppendSlice(result, node.Slice(0, tokenIndex))
+			return reflect.AppendSlice(result, node.Slice(tokenIndex+1, sLength)), nil
+		}
+		elem := node.Index(tokenIndex)
+		var val reflect.Value
+		if last {
+			val, err = valueFor(value, elem.Type())
+		} else {
+			val, err = setImpl(elem, tokens[1:], value, nameProvider)
+		}
+		if err != nil {
+			return node, err
+		}
+		elem.Set(val)
+		return node, nil
+
+	default:
+		return node, fmt.Errorf("invalid token reference %q", decodedToken)
+	}
+}
+
+// setCustom sets the value for a token that isn't a field of the struct with its JSONSetable implementation
+func setCustom(node reflect.Value, tokens []string, value interface{}, nameProvider *swag.NameProvider) (reflect.Value, error) {
+	decodedToken := tokens[0]
+	if !node.CanAddr() || !node.Addr().Type().Implements(jsonSetableType) {
+		return node, fmt.Errorf("object has no field %q", decodedToken)
+	}
+	setable := node.Addr().Interface().(JSONSetable)
+	if len(tokens) == 1 {
+		if value == deleteValue {
+			value = nil
+		}
+		return node, setable.JSONSet(decodedToken, value)
+	}
+
+	if !node.Type().Implements(jsonPointableType) {
+		return node, fmt.Errorf("object has no field %q", decodedToken)
+	}
+	child, err := node.Interface().(JSONPointable).JSONLookup(decodedToken)
+	if err != nil {
+		return node, err
+	}
+	if child == nil {
+		return node, fmt.Errorf("invalid token reference %q", decodedToken)
+	}
+	val, err := setImpl(reflect.ValueOf(child), tokens[1:], value, nameProvider)
+	if err != nil {
+		return node, err
+	}
+	return node, setable.JSONSet(decodedToken, reflect.Indirect(val).Interface())
+}
+
+// valueFor the value to set in a place of the type, numbers get converted to other kinds of numbers
+// and other values get converted through their json representation, like a map to a struct
+func valueFor(value interface{}, tpe reflect.Type) (reflect.Value, error) {
+	if value == nil {
+		return reflect.Zero(tpe), nil
+	}
+	val := reflect.ValueOf(value)
+	if val.Type().AssignableTo(tpe) {
+		return val, nil
+	}
+	if isNumber(val.Kind()) && isNumber(tpe.Kind()) {
+		return val.Convert(tpe), nil
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return val, err
+	}
+	converted := reflect.New(tpe)
+	if err := json.Unmarshal(b, converted.Interface()); err != nil {
+		return val, fmt.Errorf("can't use a %T as %s: %v", value, tpe, err)
+	}
+	return converted.Elem(), nil
+}
+
+func isNumber(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
 // DecodedTokens returns the decoded tokens
 func (p *Pointer) DecodedTokens() []string {
 	result := make([]string, 0, len(p.referenceTokens))
@@ -311,3 +311,179 @@ func TestObject(t *testing.T) {
 		assert.EqualValues(t, outs[i], result)
 	}
 }
+
+func TestSetNode(t *testing.T) {
+	var doc interface{}
+	json.Unmarshal([]byte(TestDocumentString), &doc)
+
+	ins := []string{`/foo/0`, `/obj/a`, `/obj/d/1/f/1`, `/obj/new`, `/foo/-`}
+	values := []interface{}{"qux", 10, 52, "hello", "last"}
+	for i := range ins {
+		p, err := New(ins[i])
+		assert.NoError(t, err)
+
+		doc, err = p.Set(doc, values[i])
+		assert.NoError(t, err)
+	}
+
+	var expected interface{}
+	json.Unmarshal([]byte(TestDocumentString), &expected)
+	obj := expected.(map[string]interface{})
+	obj["foo"] = []interface{}{"qux", "baz", "last"}
+	obj["obj"].(map[string]interface{})["a"] = 10
+	obj["obj"].(map[string]interface{})["new"] = "hello"
+	obj["obj"].(map[string]interface{})["d"].([]interface{})[1].(map[string]interface{})["f"] = []interface{}{float64(50), 52}
+	assert.Equal(t, expected, doc)
+
+	p, err := New("")
+	assert.NoError(t, err)
+	doc, err = p.Set(doc, "root")
+	assert.NoError(t, err)
+	assert.Equal(t, "root", doc)
+
+	p, err = New("/notthere/a")
+	assert.NoError(t, err)
+	_, err = p.Set(map[string]interface{}{}, 1)
+	assert.Error(t, err)
+
+	p, err = New("/foo/5")
+	assert.NoError(t, err)
+	_, err = p.Set(map[string]interface{}{"foo": []interface{}{1}}, 1)
+	assert.Error(t, err)
+}
+
+func TestSetStruct(t *testing.T) {
+	var doc testStructJSON
+	json.Unmarshal([]byte(TestDocumentString), &doc)
+
+	p, err := New("/obj/d/1/f/0")
+	assert.NoError(t, err)
+	_, err = p.Set(&doc, 60)
+	assert.NoError(t, err)
+	assert.Equal(t, 60, doc.Obj.D[1].F[0])
+
+	p, err = New("/foo/-")
+	assert.NoError(t, err)
+	_, err = p.Set(&doc, "qux")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bar", "baz", "qux"}, doc.Foo)
+
+	// a struct that isn't passed by pointer gets copied
+	p, err = New("/obj/b")
+	assert.NoError(t, err)
+	result, err := p.Set(doc, 20)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, doc.Obj.B)
+	assert.Equal(t, 20, result.(testStructJSON).Obj.B)
+
+	p, err = New("/obj/a")
+	assert.NoError(t, err)
+	_, err = p.Set(&doc, "not a number")
+	assert.Error(t, err)
+
+	p, err = New("/obj/nope")
+	assert.NoError(t, err)
+	_, err = p.Set(&doc, 1)
+	assert.Error(t, err)
+
+	m := map[string]testStructJSON{"doc": doc}
+	p, err = New("/doc/obj/c/1")
+	assert.NoError(t, err)
+	_, err = p.Set(m, 40)
+	assert.NoError(t, err)
+	assert.Equal(t, 40, m["doc"].Obj.C[1])
+}
+
+type setableImpl struct {
+	Name  string                 `json:"name"`
+	Extra map[string]interface{} `json:"-"`
+}
+
+func (s setableImpl) JSONLookup(token string) (interface{}, error) {
+	if v, ok := s.Extra[token]; ok {
+		return &v, nil
+	}
+	return nil, fmt.Errorf("object has no field %q", token)
+}
+
+func (s *setableImpl) JSONSet(token string, value interface{}) error {
+	if value == nil {
+		delete(s.Extra, token)
+		return nil
+	}
+	if s.Extra == nil {
+		s.Extra = make(map[string]interface{})
+	}
+	s.Extra[token] = value
+	return nil
+}
+
+func TestSetableInterface(t *testing.T) {
+	doc := map[string]setableImpl{"a": {Name: "a"}}
+
+	p, err := New("/a/name")
+	assert.NoError(t, err)
+	_, err = p.Set(doc, "b")
+	assert.NoError(t, err)
+	assert.Equal(t, "b", doc["a"].Name)
+	assert.Empty(t, doc["a"].Extra)
+
+	p, err = New("/a/extra")
+	assert.NoError(t, err)
+	_, err = p.Set(doc, map[string]interface{}{"c": 1})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"c": 1}, doc["a"].Extra["extra"])
+
+	p, err = New("/a/extra/c")
+	assert.NoError(t, err)
+	_, err = p.Set(doc, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"c": 2}, doc["a"].Extra["extra"])
+
+	p, err = New("/a/extra")
+	assert.NoError(t, err)
+	_, err = p.Delete(doc)
+	assert.NoError(t, err)
+	assert.NotContains(t, doc["a"].Extra, "extra")
+
+	p, err = New("/a/nope/c")
+	assert.NoError(t, err)
+	_, err = p.Set(doc, 2)
+	assert.Error(t, err)
+}
+
+func TestDeleteNode(t *testing.T) {
+	var doc interface{}
+	json.Unmarshal([]byte(TestDocumentString), &doc)
+
+	for _, ptr := range []string{`/foo/0`, `/obj/a`, `/obj/d/1/f/0`, `/a~1b`} {
+		p, err := New(ptr)
+		assert.NoError(t, err)
+		doc, err = p.Delete(doc)
+		assert.NoError(t, err)
+	}
+
+	obj := doc.(map[string]interface{})
+	assert.Equal(t, []interface{}{"baz"}, obj["foo"])
+	assert.NotContains(t, obj["obj"], "a")
+	assert.NotContains(t, obj, "a/b")
+	assert.Equal(t, []interface{}{float64(51)}, obj["obj"].(map[string]interface{})["d"].([]interface{})[1].(map[string]interface{})["f"])
+
+	p, err := New("/obj/a")
+	assert.NoError(t, err)
+	_, err = p.Delete(doc)
+	assert.Error(t, err)
+
+	p, err = New("")
+	assert.NoError(t, err)
+	_, err = p.Delete(doc)
+	assert.Error(t, err)
+
+	var st testStructJSON
+	json.Unmarshal([]byte(TestDocumentString), &st)
+	p, err = New("/obj/c")
+	assert.NoError(t, err)
+	_, err = p.Delete(&st)
+	assert.NoError(t, err)
+	assert.Nil(t, st.Obj.C)
+}
@@ -3,6 +3,9 @@ package scan
 import (
 	"fmt"
 	"go/ast"
+	"go/types"
+	"sort"
+	"strings"
 
 	"golang.org/x/tools/go/loader"
 )
@@ -12,11 +15,23 @@ type packageFilter struct {
 }
 
 func (pf *packageFilter) Matches(path string) bool {
+	if strings.HasSuffix(pf.Name, "/...") {
+		prefix := strings.TrimSuffix(pf.Name, "/...")
+		return path == prefix || strings.HasPrefix(path, prefix+"/")
+	}
 	return path == pf.Name
 }
 
 type packageFilters []packageFilter
 
+func newPackageFilters(patterns []string) packageFilters {
+	var pf packageFilters
+	for _, pattern := range patterns {
+		pf = append(pf, packageFilter{Name: pattern})
+	}
+	return pf
+}
+
 func (pf packageFilters) HasFilters() bool {
 	return len(pf) > 0
 }
@@ -56,7 +71,16 @@ type programClassifier struct {
 
 func (pc *programClassifier) Classify(prog *loader.Program) (*classifiedProgram, error) {
 	var cp classifiedProgram
-	for pkg, pkgInfo := range prog.AllPackages {
+
+	// visit the packages in a stable order, so that the spec comes out the same for every run
+	var pkgs []*types.Package
+	for pkg := range prog.AllPackages {
+		pkgs = append(pkgs, pkg)
+	}
+	sort.Sort(packagesByPath(pkgs))
+
+	for _, pkg := range pkgs {
+		pkgInfo := prog.AllPackages[pkg]
 		if pc.Includes.HasFilters() {
 			if !pc.Includes.Matches(pkg.Path()) {
 				continue
@@ -99,6 +123,8 @@ func (pc *programClassifier) Classify(prog *loader.Program) (*classifiedProgram,
 					case "strfmt":
 						// TODO: perhaps collect these and pass along to avoid lookups later on
 					case "allOf":
+					case "enum":
+					case "ignore":
 					default:
 						return nil, fmt.Errorf("classifier: unknown swagger annotation %q", matches[1])
 					}
@@ -109,3 +135,9 @@ func (pc *programClassifier) Classify(prog *loader.Program) (*classifiedProgram,
 
 	return &cp, nil
 }
+
+type packagesByPath []*types.Package
+
+func (p packagesByPath) Len() int           { return len(p) }
+func (p packagesByPath) Less(i, j int) bool { return p[i].Path() < p[j].Path() }
+func (p packagesByPath) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
@@ -7,6 +7,13 @@ To use you can add a go:generate comment to your main file for example:
 
 		//go:generate swagger generate spec
 
+The packages that get scanned can be narrowed down with --include and --exclude, a pattern that ends in /...
+matches a package and all the packages below it.
+
+When an input spec is provided, what is scanned gets merged into it. The --merge flag decides what happens
+when both declare the same thing differently: scanned-wins (the default) keeps what was found in the code,
+input-wins keeps the input spec and error-on-conflict fails. Every conflict gets reported with its json pointer.
+
 The following annotations exist:
 
 swagger:meta
@@ -21,14 +28,36 @@ http, https, ws or wss
 Host and BasePath can be specified but those values will be defaults,
 they should get substituted when serving the swagger spec.
 
+SecurityDefinitions is a section that declares the security schemes of the API, it's written as yaml
+that keeps its indentation in the comment. Each entry has a type of basic, apiKey or oauth2 and the
+properties that go with the type, like name and in for an api key or flow, authorizationUrl, tokenUrl
+and scopes for oauth2. A route refers to these schemes by name in its Security section.
+
 Default parameters and responses are not supported at this stage, for those you can edit the template json.
 
+Extensions is a section that adds vendor extensions, like SecurityDefinitions it's written as yaml.
+Every extension name has to start with x-. The section can be used in the comments for swagger:meta,
+swagger:route, swagger:model, swagger:response, struct fields of models and parameters.
+
 swagger:strfmt [name]
 
 A swagger:strfmt annotation names a type as a string formatter. The name is mandatory and that is
 what will be used as format name for this particular string format.
 String formats should only be used for very well known formats.
 
+swagger:enum
+
+A swagger:enum annotation on a type with a primitive underlying type turns the constants declared
+with that type in the same package into the enum of every property, parameter or header of that type.
+A single property, parameter or header can also list its allowed values with an Enum: directive,
+as a comma separated list.
+
+swagger:ignore
+
+A swagger:ignore annotation on a type or a struct field leaves it out of the spec.
+Fields are also left out when their json struct tag is "-", fields tagged with omitempty are never required
+and the string option turns numbers and booleans into strings.
+
 swagger:model [?model name]
 
 A swagger:model annotation optionally gets a model name as extra data on the line.
@@ -48,6 +77,12 @@ Because there are many routers available, this tool does not try to parse the pa
 you provided to your routing library of choice. So you have to specify your path pattern
 yourself in valid swagger syntax.
 
+When the scanner infers signatures (swagger generate spec --infer-signatures) and the annotation
+is the doc comment of a function, the struct arguments of that function become the parameters of the
+operation and its first result that isn't an error becomes the 200 response.
+A result type annotated with swagger:response is used by reference, any other type becomes the schema.
+Parameters and responses declared through comments take precedence over the inferred ones.
+
 swagger:params [operationid1 operationid2]
 
 Links a struct to one or more operations. The params in the resulting swagger spec can be composed of several structs.
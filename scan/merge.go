@@ -0,0 +1,344 @@
+package scan
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/casualjim/go-swagger/jsonpointer"
+	"github.com/casualjim/go-swagger/spec"
+)
+
+// MergeStrategy decides what happens when the input spec and the scanned code both
+// declare the same thing differently
+type MergeStrategy string
+
+const (
+	// ScannedWins keeps what was found in the code
+	ScannedWins MergeStrategy = "scanned-wins"
+	// InputWins keeps what was declared in the input spec
+	InputWins MergeStrategy = "input-wins"
+	// ErrorOnConflict fails the merge when there is any conflict
+	ErrorOnConflict MergeStrategy = "error-on-conflict"
+)
+
+// Conflict is something that is declared differently in the input spec and in the scanned code
+type Conflict struct {
+	// Location is the json pointer to the conflicting value
+	Location string
+	// Kept is the side that won, either "input" or "scanned"
+	Kept string
+}
+
+func (c Conflict) String() string {
+	if c.Kept == "" {
+		return c.Location
+	}
+	return fmt.Sprintf("%s (kept %s)", c.Location, c.Kept)
+}
+
+// ConflictError is returned when the ErrorOnConflict strategy finds conflicts
+type ConflictError struct {
+	Conflicts []Conflict
+}
+
+func (c *ConflictError) Error() string {
+	var locations []string
+	for _, conflict := range c.Conflicts {
+		locations = append(locations, conflict.Location)
+	}
+	return fmt.Sprintf("the scanned code conflicts with the input spec at: %s", strings.Join(locations, ", "))
+}
+
+// Merge merges the scanned spec into the input spec, the strategy decides
+// which side is kept when both declare the same thing differently.
+// It returns the conflicts it found, for the ErrorOnConflict strategy they are returned as error.
+func Merge(input, scanned *spec.Swagger, strategy MergeStrategy) ([]Conflict, error) {
+	return mergeSpecs(input, scanned, nil, strategy)
+}
+
+// mergeSpecs merges into a copy of the input spec, the input spec is only updated
+// when the merge succeeds. So with the ErrorOnConflict strategy a failed merge leaves it as it was.
+func mergeSpecs(input, scanned *spec.Swagger, orphans map[string]*spec.Operation, strategy MergeStrategy) ([]Conflict, error) {
+	if strategy == "" {
+		strategy = ScannedWins
+	}
+	switch strategy {
+	case ScannedWins, InputWins, ErrorOnConflict:
+	default:
+		return nil, fmt.Errorf("unknown merge strategy %q", strategy)
+	}
+
+	m := &specMerger{strategy: strategy}
+	merged := copySpec(input)
+	m.mergeMeta(merged, scanned)
+	m.mergePaths(merged, scanned)
+	for _, id := range sortedKeys(orphans) {
+		m.mergeOperationParameters(merged, id, orphans[id])
+	}
+
+	spec.MergeMap("/definitions", &merged.Definitions, scanned.Definitions, m.resolve)
+	spec.MergeMap("/responses", &merged.Responses, scanned.Responses, m.resolve)
+	spec.MergeMap("/parameters", &merged.Parameters, scanned.Parameters, m.resolve)
+	spec.MergeMap("/securityDefinitions", &merged.SecurityDefinitions, scanned.SecurityDefinitions, m.resolve)
+	m.mergeExtensions("", &merged.Extensions, scanned.Extensions)
+
+	if strategy == ErrorOnConflict && len(m.conflicts) > 0 {
+		return m.conflicts, &ConflictError{Conflicts: m.conflicts}
+	}
+	*input = *merged
+	return m.conflicts, nil
+}
+
+// copySpec copies the parts of the spec the merge changes, the rest is shared with the original.
+// The merge replaces the values it changes, it never changes a value it didn't copy.
+func copySpec(doc *spec.Swagger) *spec.Swagger {
+	cp := *doc
+	if doc.Info != nil {
+		info := *doc.Info
+		cp.Info = &info
+	}
+	if doc.Paths != nil {
+		paths := *doc.Paths
+		paths.Paths = make(map[string]spec.PathItem, len(doc.Paths.Paths))
+		for k, v := range doc.Paths.Paths {
+			paths.Paths[k] = v
+		}
+		cp.Paths = &paths
+	}
+	if doc.Definitions != nil {
+		cp.Definitions = make(spec.Definitions, len(doc.Definitions))
+		for k, v := range doc.Definitions {
+			cp.Definitions[k] = v
+		}
+	}
+	if doc.Responses != nil {
+		cp.Responses = make(map[string]spec.Response, len(doc.Responses))
+		for k, v := range doc.Responses {
+			cp.Responses[k] = v
+		}
+	}
+	if doc.Parameters != nil {
+		cp.Parameters = make(map[string]spec.Parameter, len(doc.Parameters))
+		for k, v := range doc.Parameters {
+			cp.Parameters[k] = v
+		}
+	}
+	if doc.SecurityDefinitions != nil {
+		cp.SecurityDefinitions = make(spec.SecurityDefinitions, len(doc.SecurityDefinitions))
+		for k, v := range doc.SecurityDefinitions {
+			cp.SecurityDefinitions[k] = v
+		}
+	}
+	return &cp
+}
+
+type specMerger struct {
+	strategy  MergeStrategy
+	conflicts []Conflict
+}
+
+// mergeKey decides the value for a location, it returns false when the input value is kept
+func (m *specMerger) mergeKey(location string, input, scanned interface{}, inInput bool) (interface{}, bool) {
+	if !inInput {
+		return scanned, true
+	}
+	if reflect.DeepEqual(input, scanned) || !m.resolve(location, input, scanned) {
+		return nil, false
+	}
+	return scanned, true
+}
+
+// resolve decides with the strategy which side wins for a value the specs declare differently,
+// it records the conflict and returns true when the scanned value wins
+func (m *specMerger) resolve(location string, input, scanned interface{}) bool {
+	switch m.strategy {
+	case InputWins:
+		m.conflicts = append(m.conflicts, Conflict{Location: location, Kept: "input"})
+		return false
+	case ErrorOnConflict:
+		m.conflicts = append(m.conflicts, Conflict{Location: location})
+		return false
+	default:
+		m.conflicts = append(m.conflicts, Conflict{Location: location, Kept: "scanned"})
+		return true
+	}
+}
+
+func (m *specMerger) mergeString(location string, input *string, scanned string) {
+	if scanned == "" {
+		return
+	}
+	if v, ok := m.mergeKey(location, *input, scanned, *input != ""); ok {
+		*input = v.(string)
+	}
+}
+
+func (m *specMerger) mergeStrings(location string, input *[]string, scanned []string) {
+	if len(scanned) == 0 {
+		return
+	}
+	if v, ok := m.mergeKey(location, *input, scanned, len(*input) > 0); ok {
+		*input = v.([]string)
+	}
+}
+
+func (m *specMerger) mergeMeta(input, scanned *spec.Swagger) {
+	m.mergeString("/host", &input.Host, scanned.Host)
+	m.mergeString("/basePath", &input.BasePath, scanned.BasePath)
+	m.mergeStrings("/schemes", &input.Schemes, scanned.Schemes)
+	m.mergeStrings("/consumes", &input.Consumes, scanned.Consumes)
+	m.mergeStrings("/produces", &input.Produces, scanned.Produces)
+	if len(scanned.Security) > 0 {
+		if v, ok := m.mergeKey("/security", input.Security, scanned.Security, len(input.Security) > 0); ok {
+			input.Security = v.([]map[string][]string)
+		}
+	}
+
+	if scanned.Info == nil {
+		return
+	}
+	if input.Info == nil {
+		input.Info = scanned.Info
+		return
+	}
+	m.mergeString("/info/title", &input.Info.Title, scanned.Info.Title)
+	m.mergeString("/info/description", &input.Info.Description, scanned.Info.Description)
+	m.mergeString("/info/version", &input.Info.Version, scanned.Info.Version)
+	m.mergeString("/info/termsOfService", &input.Info.TermsOfService, scanned.Info.TermsOfService)
+	if scanned.Info.Contact != nil {
+		if v, ok := m.mergeKey("/info/contact", input.Info.Contact, scanned.Info.Contact, input.Info.Contact != nil); ok {
+			input.Info.Contact = v.(*spec.ContactInfo)
+		}
+	}
+	if scanned.Info.License != nil {
+		if v, ok := m.mergeKey("/info/license", input.Info.License, scanned.Info.License, input.Info.License != nil); ok {
+			input.Info.License = v.(*spec.License)
+		}
+	}
+}
+
+func (m *specMerger) mergePaths(input, scanned *spec.Swagger) {
+	if scanned.Paths == nil {
+		return
+	}
+	if input.Paths == nil {
+		input.Paths = new(spec.Paths)
+	}
+	if input.Paths.Paths == nil {
+		input.Paths.Paths = make(map[string]spec.PathItem)
+	}
+	for _, pth := range sortedKeys(scanned.Paths.Paths) {
+		scannedItem := scanned.Paths.Paths[pth]
+		inputItem := input.Paths.Paths[pth]
+		location := "/paths/" + jsonpointer.Escape(pth)
+		mergeOp := func(method string, in **spec.Operation, sc *spec.Operation) {
+			if sc == nil {
+				return
+			}
+			if v, ok := m.mergeKey(location+"/"+method, *in, sc, *in != nil); ok {
+				*in = v.(*spec.Operation)
+			}
+		}
+		mergeOp("get", &inputItem.Get, scannedItem.Get)
+		mergeOp("put", &inputItem.Put, scannedItem.Put)
+		mergeOp("post", &inputItem.Post, scannedItem.Post)
+		mergeOp("delete", &inputItem.Delete, scannedItem.Delete)
+		mergeOp("options", &inputItem.Options, scannedItem.Options)
+		mergeOp("head", &inputItem.Head, scannedItem.Head)
+		mergeOp("patch", &inputItem.Patch, scannedItem.Patch)
+
+		if ref := scannedItem.Ref.String(); ref != "" {
+			if v, ok := m.mergeKey(location+"/$ref", inputItem.Ref.String(), ref, inputItem.Ref.String() != ""); ok {
+				inputItem.Ref = spec.MustCreateRef(v.(string))
+			}
+		}
+		m.mergeParameters(location, &inputItem.Parameters, scannedItem.Parameters)
+		m.mergeExtensions(location, &inputItem.Extensions, scannedItem.Extensions)
+		input.Paths.Paths[pth] = inputItem
+	}
+}
+
+// mergeOperationParameters adds the parameters of the scanned operation to the operation from the input spec
+// that has the same id, this covers parameter structs for operations that are only declared in the input spec
+func (m *specMerger) mergeOperationParameters(input *spec.Swagger, id string, scanned *spec.Operation) {
+	pth, method := operationByID(input, id)
+	if pth == "" {
+		return
+	}
+	item := input.Paths.Paths[pth]
+	ptr := item.Operations()[method]
+	op := **ptr
+	m.mergeParameters("/paths/"+jsonpointer.Escape(pth)+"/"+method, &op.Parameters, scanned.Parameters)
+	*ptr = &op
+	input.Paths.Paths[pth] = item
+}
+
+// mergeParameters merges the scanned parameters into a copy of the input parameters,
+// a parameter is known by its name and location
+func (m *specMerger) mergeParameters(location string, input *[]spec.Parameter, scanned []spec.Parameter) {
+	if len(scanned) == 0 {
+		return
+	}
+	params := append([]spec.Parameter(nil), (*input)...)
+	for _, sp := range scanned {
+		idx := -1
+		for i, ip := range params {
+			if ip.Name == sp.Name && ip.In == sp.In {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			params = append(params, sp)
+			continue
+		}
+		if v, ok := m.mergeKey(fmt.Sprintf("%s/parameters/%d", location, idx), params[idx], sp, true); ok {
+			params[idx] = v.(spec.Parameter)
+		}
+	}
+	*input = params
+}
+
+// mergeExtensions merges the scanned vendor extensions into a copy of the input extensions
+func (m *specMerger) mergeExtensions(location string, input *spec.Extensions, scanned spec.Extensions) {
+	if len(scanned) == 0 {
+		return
+	}
+	exts := make(spec.Extensions, len(*input)+len(scanned))
+	for k, v := range *input {
+		exts[k] = v
+	}
+	spec.MergeMap(location, &exts, scanned, m.resolve)
+	*input = exts
+}
+
+// operationByID the path and method of the operation with the id
+func operationByID(doc *spec.Swagger, id string) (string, string) {
+	if doc.Paths == nil {
+		return "", ""
+	}
+	for _, pth := range sortedKeys(doc.Paths.Paths) {
+		item := doc.Paths.Paths[pth]
+		for method, ptr := range item.Operations() {
+			if op := *ptr; op != nil && op.ID == id {
+				return pth, method
+			}
+		}
+	}
+	return "", ""
+}
+
+func sortedKeys(data interface{}) []string {
+	val := reflect.ValueOf(data)
+	if val.Kind() != reflect.Map {
+		return nil
+	}
+	var keys []string
+	for _, k := range val.MapKeys() {
+		keys = append(keys, k.String())
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,203 @@
+package scan
+
+import (
+	"testing"
+
+	"github.com/casualjim/go-swagger/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+func mergeInput() *spec.Swagger {
+	doc := new(spec.Swagger)
+	doc.Host = "petstore.example.com"
+	doc.BasePath = "/v1"
+	doc.Info = new(spec.Info)
+	doc.Info.Title = "Petstore"
+	doc.Info.Version = "1.0.0"
+	doc.Definitions = spec.Definitions{
+		"pet":   *spec.StringProperty(),
+		"order": *spec.Int64Property(),
+	}
+	doc.Paths = &spec.Paths{Paths: map[string]spec.PathItem{"/orders": testPathItem("listOrders")}}
+	return doc
+}
+
+func mergeScanned() *spec.Swagger {
+	doc := new(spec.Swagger)
+	doc.Host = "localhost"
+	doc.Info = new(spec.Info)
+	doc.Info.Title = "Petstore"
+	doc.Info.Version = "2.0.0"
+	doc.Definitions = spec.Definitions{
+		"pet":   *spec.Int64Property(),
+		"order": *spec.Int64Property(),
+		"user":  *spec.StringProperty(),
+	}
+	doc.Paths = &spec.Paths{Paths: map[string]spec.PathItem{"/pets": testPathItem("listPets")}}
+	return doc
+}
+
+func TestMerge_ScannedWins(t *testing.T) {
+	input := mergeInput()
+	conflicts, err := Merge(input, mergeScanned(), ScannedWins)
+	assert.NoError(t, err)
+	assert.Equal(t, []Conflict{
+		{Location: "/host", Kept: "scanned"},
+		{Location: "/info/version", Kept: "scanned"},
+		{Location: "/definitions/pet", Kept: "scanned"},
+	}, conflicts)
+
+	assert.Equal(t, "localhost", input.Host)
+	assert.Equal(t, "/v1", input.BasePath)
+	assert.Equal(t, "2.0.0", input.Info.Version)
+	assert.Equal(t, *spec.Int64Property(), input.Definitions["pet"])
+	assert.Len(t, input.Definitions, 3)
+	assert.NotNil(t, input.Paths.Paths["/pets"].Get)
+	assert.NotNil(t, input.Paths.Paths["/orders"].Get)
+}
+
+func TestMerge_InputWins(t *testing.T) {
+	input := mergeInput()
+	conflicts, err := Merge(input, mergeScanned(), InputWins)
+	assert.NoError(t, err)
+	assert.Len(t, conflicts, 3)
+	for _, conflict := range conflicts {
+		assert.Equal(t, "input", conflict.Kept)
+	}
+
+	assert.Equal(t, "petstore.example.com", input.Host)
+	assert.Equal(t, "1.0.0", input.Info.Version)
+	assert.Equal(t, *spec.StringProperty(), input.Definitions["pet"])
+	assert.Equal(t, *spec.StringProperty(), input.Definitions["user"])
+	assert.NotNil(t, input.Paths.Paths["/pets"].Get)
+}
+
+func TestMerge_ErrorOnConflict(t *testing.T) {
+	conflicts, err := Merge(mergeInput(), mergeScanned(), ErrorOnConflict)
+	if assert.Error(t, err) {
+		assert.IsType(t, &ConflictError{}, err)
+		assert.Equal(t, "the scanned code conflicts with the input spec at: /host, /info/version, /definitions/pet", err.Error())
+	}
+	assert.Len(t, conflicts, 3)
+
+	conflicts, err = Merge(mergeInput(), mergeInput(), ErrorOnConflict)
+	assert.NoError(t, err)
+	assert.Empty(t, conflicts)
+
+	_, err = Merge(mergeInput(), mergeScanned(), MergeStrategy("last-wins"))
+	assert.Error(t, err)
+}
+
+func TestMerge_ErrorOnConflictKeepsInput(t *testing.T) {
+	input := mergeInput()
+	input.Paths.Paths["/orders"].Get.Parameters = []spec.Parameter{*spec.QueryParam("limit").Typed("string", "")}
+	scanned := mergeScanned()
+	scanned.Responses = map[string]spec.Response{"error": *spec.NewResponse()}
+	scanned.AddExtension("x-scanned", true)
+	orphan := testOperation("listOrders")
+	orphan.Parameters = []spec.Parameter{*spec.QueryParam("offset").Typed("integer", "int32")}
+
+	_, err := mergeSpecs(input, scanned, map[string]*spec.Operation{"listOrders": orphan}, ErrorOnConflict)
+	assert.Error(t, err)
+	assert.Equal(t, mergeInput().Host, input.Host)
+	assert.Equal(t, "1.0.0", input.Info.Version)
+	assert.Len(t, input.Definitions, 2)
+	assert.Nil(t, input.Responses)
+	assert.Nil(t, input.Extensions)
+	assert.Len(t, input.Paths.Paths, 1)
+	assert.Len(t, input.Paths.Paths["/orders"].Get.Parameters, 1)
+}
+
+func TestMerge_PathItems(t *testing.T) {
+	input := mergeInput()
+	item := input.Paths.Paths["/orders"]
+	item.Parameters = []spec.Parameter{*spec.HeaderParam("X-Tenant").Typed("string", "")}
+	item.AddExtension("x-owner", "orders")
+	input.Paths.Paths["/orders"] = item
+
+	scanned := mergeScanned()
+	scannedItem := testPathItem("listOrders")
+	scannedItem.Parameters = []spec.Parameter{
+		*spec.HeaderParam("X-Tenant").Typed("integer", "int64"),
+		*spec.QueryParam("limit").Typed("integer", "int32"),
+	}
+	scannedItem.AddExtension("x-owner", "shop")
+	scannedItem.AddExtension("x-internal", true)
+	scanned.Paths.Paths["/orders"] = scannedItem
+	refItem := spec.PathItem{}
+	refItem.Ref = spec.MustCreateRef("pets.json#/paths/~1pets")
+	scanned.Paths.Paths["/pets/{id}"] = refItem
+
+	conflicts, err := Merge(input, scanned, InputWins)
+	assert.NoError(t, err)
+	assert.Contains(t, conflicts, Conflict{Location: "/paths/~1orders/parameters/0", Kept: "input"})
+	assert.Contains(t, conflicts, Conflict{Location: "/paths/~1orders/x-owner", Kept: "input"})
+
+	merged := input.Paths.Paths["/orders"]
+	if assert.Len(t, merged.Parameters, 2) {
+		assert.Equal(t, "string", merged.Parameters[0].Type)
+		assert.Equal(t, "limit", merged.Parameters[1].Name)
+	}
+	assert.Equal(t, "orders", merged.Extensions["x-owner"])
+	assert.Equal(t, true, merged.Extensions["x-internal"])
+	refItem = input.Paths.Paths["/pets/{id}"]
+	assert.Equal(t, "pets.json#/paths/~1pets", refItem.Ref.String())
+}
+
+func TestMerge_OperationParameters(t *testing.T) {
+	input := mergeInput()
+	input.Paths.Paths["/orders"].Get.Parameters = []spec.Parameter{*spec.QueryParam("limit").Typed("string", "")}
+
+	scanned := testOperation("listOrders")
+	scanned.Parameters = []spec.Parameter{
+		*spec.QueryParam("limit").Typed("integer", "int32"),
+		*spec.QueryParam("offset").Typed("integer", "int32"),
+	}
+	conflicts, err := mergeSpecs(input, &spec.Swagger{}, map[string]*spec.Operation{"listOrders": scanned}, ScannedWins)
+	assert.NoError(t, err)
+	assert.Equal(t, []Conflict{{Location: "/paths/~1orders/get/parameters/0", Kept: "scanned"}}, conflicts)
+
+	params := input.Paths.Paths["/orders"].Get.Parameters
+	if assert.Len(t, params, 2) {
+		assert.Equal(t, "integer", params[0].Type)
+		assert.Equal(t, "offset", params[1].Name)
+	}
+}
+
+func TestRun_MergesInput(t *testing.T) {
+	input := new(spec.Swagger)
+	input.Definitions = spec.Definitions{"pet": *spec.StringProperty()}
+	_, err := Run(&Opts{BasePath: "../fixtures/goparsing/handlers", Input: input, Merge: ErrorOnConflict})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "/definitions/pet")
+	}
+
+	doc, err := Run(&Opts{BasePath: "../fixtures/goparsing/handlers", Input: input, Merge: InputWins})
+	if assert.NoError(t, err) {
+		assert.Equal(t, *spec.StringProperty(), doc.Definitions["pet"])
+		assert.NotNil(t, doc.Paths.Paths["/pets"].Get)
+	}
+}
+
+func TestPackageFilterMatches(t *testing.T) {
+	pf := packageFilter{Name: "github.com/casualjim/go-swagger/fixtures/goparsing/classification"}
+	assert.True(t, pf.Matches("github.com/casualjim/go-swagger/fixtures/goparsing/classification"))
+	assert.False(t, pf.Matches("github.com/casualjim/go-swagger/fixtures/goparsing/classification/models"))
+
+	pf = packageFilter{Name: "github.com/casualjim/go-swagger/fixtures/goparsing/classification/..."}
+	assert.True(t, pf.Matches("github.com/casualjim/go-swagger/fixtures/goparsing/classification"))
+	assert.True(t, pf.Matches("github.com/casualjim/go-swagger/fixtures/goparsing/classification/models"))
+	assert.False(t, pf.Matches("github.com/casualjim/go-swagger/fixtures/goparsing/classifications"))
+}
+
+func testOperation(id string) *spec.Operation {
+	op := new(spec.Operation)
+	op.ID = id
+	return op
+}
+
+func testPathItem(id string) spec.PathItem {
+	var item spec.PathItem
+	item.Get = testOperation(id)
+	return item
+}
@@ -1,11 +1,15 @@
 package scan
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/mail"
 	"regexp"
 	"strings"
 
 	"github.com/casualjim/go-swagger/spec"
+	"github.com/casualjim/go-swagger/swag"
+	"gopkg.in/yaml.v2"
 )
 
 var allSwaggerTags = []string{
@@ -19,6 +23,8 @@ var allSwaggerTags = []string{
 	"Version",
 	"License",
 	"Contact",
+	"SecurityDefinitions",
+	"Extensions",
 }
 
 func metaTOSSetter(meta *spec.Info) func([]string) {
@@ -39,6 +45,17 @@ func metaSchemeSetter(meta *spec.Swagger) func([]string) {
 	return func(schemes []string) { meta.Schemes = schemes }
 }
 
+func metaSecurityDefinitionsSetter(meta *spec.Swagger) func(json.RawMessage) error {
+	return func(jsonValue json.RawMessage) error {
+		var jsonData spec.SecurityDefinitions
+		if err := json.Unmarshal(jsonValue, &jsonData); err != nil {
+			return err
+		}
+		meta.SecurityDefinitions = jsonData
+		return nil
+	}
+}
+
 func newMetaParser(swspec *spec.Swagger) *sectionedParser {
 	sp := new(sectionedParser)
 	if swspec.Info == nil {
@@ -63,10 +80,64 @@ func newMetaParser(swspec *spec.Swagger) *sectionedParser {
 		newSingleLineTagParser("BasePath", &setMetaSingle{swspec, rxBasePath, setSwaggerBasePath}),
 		newSingleLineTagParser("Contact", &setMetaSingle{swspec, rxContact, setInfoContact}),
 		newSingleLineTagParser("License", &setMetaSingle{swspec, rxLicense, setInfoLicense}),
+		newMultiLineTagParser("SecurityDefinitions", newYAMLParser(rxSecurityDefinitions, metaSecurityDefinitionsSetter(swspec))),
+		newMultiLineTagParser("Extensions", newSetExtensions(swspec.AddExtension)),
 	}
 	return sp
 }
 
+func newYAMLParser(rx *regexp.Regexp, set func(json.RawMessage) error) *yamlParser {
+	return &yamlParser{rx: rx, set: set}
+}
+
+// yamlParser reads the lines of a section as a yaml document and
+// hands it to the setter as json
+type yamlParser struct {
+	rx  *regexp.Regexp
+	set func(json.RawMessage) error
+}
+
+func (y *yamlParser) Matches(line string) bool {
+	return y.rx.MatchString(line)
+}
+
+func (y *yamlParser) Indented() bool {
+	return true
+}
+
+func (y *yamlParser) Parse(lines []string) error {
+	if len(lines) == 0 || (len(lines) == 1 && len(lines[0]) == 0) {
+		return nil
+	}
+	var yamlValue interface{}
+	if err := yaml.Unmarshal([]byte(strings.Join(lines, "\n")), &yamlValue); err != nil {
+		return err
+	}
+	jsonValue, err := swag.YAMLToJSON(yamlValue)
+	if err != nil {
+		return err
+	}
+	return y.set(jsonValue)
+}
+
+// newSetExtensions reads the vendor extensions of an Extensions section, every
+// extension name has to start with x-
+func newSetExtensions(set func(string, interface{})) *yamlParser {
+	return newYAMLParser(rxExtensions, func(jsonValue json.RawMessage) error {
+		var extensions map[string]interface{}
+		if err := json.Unmarshal(jsonValue, &extensions); err != nil {
+			return err
+		}
+		for name, value := range extensions {
+			if !strings.HasPrefix(strings.ToLower(name), "x-") {
+				return fmt.Errorf("invalid vendor extension %q, the name should start with x-", name)
+			}
+			set(name, value)
+		}
+		return nil
+	})
+}
+
 type setMetaSingle struct {
 	spec *spec.Swagger
 	rx   *regexp.Regexp
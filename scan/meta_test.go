@@ -73,6 +73,45 @@ func verifyMeta(t testing.TB, doc *spec.Swagger) {
 	assert.EqualValues(t, []string{"http", "https"}, doc.Schemes)
 	assert.Equal(t, "localhost", doc.Host)
 	assert.Equal(t, "/v2", doc.BasePath)
+	verifySecurityDefinitions(t, doc.SecurityDefinitions)
+	assert.Equal(t, "value", doc.Extensions["x-meta-value"])
+	assert.EqualValues(t, []interface{}{"value1", "value2"}, doc.Extensions["x-meta-array"])
+}
+
+func verifySecurityDefinitions(t testing.TB, definitions spec.SecurityDefinitions) {
+	if !assert.Len(t, definitions, 3) {
+		return
+	}
+	assert.Equal(t, spec.APIKeyAuth("X-API-KEY", "header"), definitions["api_key"])
+	assert.Equal(t, spec.BasicAuth(), definitions["basic"])
+
+	oauth := definitions["oauth"]
+	if assert.NotNil(t, oauth) {
+		assert.Equal(t, "oauth2", oauth.Type)
+		assert.Equal(t, "accessCode", oauth.Flow)
+		assert.Equal(t, "https://example.com/oauth/authorize", oauth.AuthorizationURL)
+		assert.Equal(t, "https://example.com/oauth/token", oauth.TokenURL)
+		assert.Equal(t, map[string]string{
+			"read":  "read access to the pets",
+			"write": "write access to the pets",
+		}, oauth.Scopes)
+	}
+}
+
+func TestUncommentIndented(t *testing.T) {
+	lines := uncommentIndented([]string{
+		"//     api_key:",
+		"//       type: apiKey",
+		"//",
+		"//       in: header",
+	})
+	assert.Equal(t, []string{"api_key:", "  type: apiKey", "", "  in: header"}, lines)
+
+	lines = uncommentIndented([]string{
+		"  basic:",
+		"    type: basic */",
+	})
+	assert.Equal(t, []string{"basic:", "  type: basic"}, lines)
 }
 
 func verifyInfo(t testing.TB, info *spec.Info) {
@@ -96,3 +135,17 @@ that are available to turn go code into a fully compliant swagger 2.0 spec`
 	assert.Equal(t, "john.doe@example.com", info.Contact.Email)
 	assert.Equal(t, "http://john.doe.com", info.Contact.URL)
 }
+
+func TestSetExtensions(t *testing.T) {
+	extensions := make(spec.Extensions)
+	parser := newSetExtensions(extensions.Add)
+	assert.True(t, parser.Matches("Extensions:"))
+
+	err := parser.Parse([]string{"x-rate-limit:", "  limit: 10", "x-internal: true"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"limit": float64(10)}, extensions["x-rate-limit"])
+	assert.Equal(t, true, extensions["x-internal"])
+
+	err = parser.Parse([]string{"rate-limit: 10"})
+	assert.Error(t, err)
+}
@@ -57,6 +57,14 @@ func (pt paramTypable) Items() swaggerTypable {
 	return itemsTypable{pt.param.Items}
 }
 
+func (pt paramTypable) WithEnum(values ...interface{}) {
+	if pt.param.In == "body" {
+		pt.Schema().WithEnum(values...)
+		return
+	}
+	pt.param.WithEnum(values...)
+}
+
 func (pt paramTypable) Schema() *spec.Schema {
 	if pt.param.In != "body" {
 		return nil
@@ -79,6 +87,10 @@ func (pt itemsTypable) SetRef(ref spec.Ref) {
 	pt.items.Ref = ref
 }
 
+func (pt itemsTypable) WithEnum(values ...interface{}) {
+	pt.items.WithEnum(values...)
+}
+
 func (pt itemsTypable) Schema() *spec.Schema {
 	return nil
 }
@@ -111,6 +123,7 @@ func (sv paramValidations) SetMaxLength(val int64)         { sv.current.MaxLengt
 func (sv paramValidations) SetPattern(val string)          { sv.current.Pattern = val }
 func (sv paramValidations) SetUnique(val bool)             { sv.current.UniqueItems = val }
 func (sv paramValidations) SetCollectionFormat(val string) { sv.current.CollectionFormat = val }
+func (sv paramValidations) SetEnum(val string)             { sv.current.Enum = parseEnum(val, sv.current.Type) }
 
 type itemsValidations struct {
 	current *spec.Items
@@ -132,6 +145,7 @@ func (sv itemsValidations) SetMaxLength(val int64)         { sv.current.MaxLengt
 func (sv itemsValidations) SetPattern(val string)          { sv.current.Pattern = val }
 func (sv itemsValidations) SetUnique(val bool)             { sv.current.UniqueItems = val }
 func (sv itemsValidations) SetCollectionFormat(val string) { sv.current.CollectionFormat = val }
+func (sv itemsValidations) SetEnum(val string)             { sv.current.Enum = parseEnum(val, sv.current.Type) }
 
 type paramDecl struct {
 	File         *ast.File
@@ -233,6 +247,8 @@ func (pp *paramStructParser) parseDecl(operations map[string]*spec.Operation, de
 
 func (pp *paramStructParser) parseEmbeddedStruct(gofile *ast.File, operation *spec.Operation, expr ast.Expr, seenPreviously map[string]spec.Parameter) error {
 	switch tpe := expr.(type) {
+	case *ast.StarExpr:
+		return pp.parseEmbeddedStruct(gofile, operation, tpe.X, seenPreviously)
 	case *ast.Ident:
 		// do lookup of type
 		// take primitives into account, they should result in an error for swagger
@@ -247,6 +263,7 @@ func (pp *paramStructParser) parseEmbeddedStruct(gofile *ast.File, operation *sp
 		if st, ok := ts.Type.(*ast.StructType); ok {
 			return pp.parseStructType(file, operation, st, seenPreviously)
 		}
+		return pp.parseEmbeddedStruct(file, operation, ts.Type, seenPreviously)
 	case *ast.SelectorExpr:
 		// look up package, file and then type
 		pkg, err := pp.scp.packageForSelector(gofile, tpe.X)
@@ -260,6 +277,7 @@ func (pp *paramStructParser) parseEmbeddedStruct(gofile *ast.File, operation *sp
 		if st, ok := ts.Type.(*ast.StructType); ok {
 			return pp.parseStructType(file, operation, st, seenPreviously)
 		}
+		return pp.parseEmbeddedStruct(file, operation, ts.Type, seenPreviously)
 	}
 	return fmt.Errorf("unable to resolve embedded struct for: %v\n", expr)
 }
@@ -267,9 +285,11 @@ func (pp *paramStructParser) parseEmbeddedStruct(gofile *ast.File, operation *sp
 func (pp *paramStructParser) parseStructType(gofile *ast.File, operation *spec.Operation, tpe *ast.StructType, seenPreviously map[string]spec.Parameter) error {
 	if tpe.Fields != nil {
 		pt := seenPreviously
+		// the names in the order of the fields, this keeps the parameters in source order
+		var names []string
 
 		for _, fld := range tpe.Fields.List {
-			if len(fld.Names) == 0 {
+			if len(fld.Names) == 0 && !skipField(fld) {
 				// when the embedded struct is annotated with swagger:allOf it will be used as allOf property
 				// otherwise the fields will just be included as normal properties
 				if err := pp.parseEmbeddedStruct(gofile, operation, fld.Type, pt); err != nil {
@@ -279,6 +299,9 @@ func (pp *paramStructParser) parseStructType(gofile *ast.File, operation *spec.O
 		}
 
 		for _, fld := range tpe.Fields.List {
+			if skipField(fld) {
+				continue
+			}
 			var nm, gnm string
 			if len(fld.Names) > 0 && fld.Names[0] != nil && fld.Names[0].IsExported() {
 				nm = fld.Names[0].Name
@@ -334,8 +357,10 @@ func (pp *paramStructParser) parseStructType(gofile *ast.File, operation *spec.O
 						newSingleLineTagParser("minItems", &setMinItems{paramValidations{&ps}, rxf(rxMinItemsFmt, "")}),
 						newSingleLineTagParser("maxItems", &setMaxItems{paramValidations{&ps}, rxf(rxMaxItemsFmt, "")}),
 						newSingleLineTagParser("unique", &setUnique{paramValidations{&ps}, rxf(rxUniqueFmt, "")}),
+						newSingleLineTagParser("enum", &setEnum{paramValidations{&ps}, rxf(rxEnumFmt, "")}),
 						newSingleLineTagParser("required", &setRequiredParam{&ps}),
 						newSingleLineTagParser("in", &matchOnlyParam{&ps, rxIn}),
+						newMultiLineTagParser("Extensions", newSetExtensions(ps.AddExtension)),
 					}
 					itemsTaggers := func() []tagParser {
 						return []tagParser{
@@ -349,6 +374,7 @@ func (pp *paramStructParser) parseStructType(gofile *ast.File, operation *spec.O
 							newSingleLineTagParser("itemsMinItems", &setMinItems{itemsValidations{ps.Items}, rxf(rxMinItemsFmt, rxItemsPrefix)}),
 							newSingleLineTagParser("itemsMaxItems", &setMaxItems{itemsValidations{ps.Items}, rxf(rxMaxItemsFmt, rxItemsPrefix)}),
 							newSingleLineTagParser("itemsUnique", &setUnique{itemsValidations{ps.Items}, rxf(rxUniqueFmt, rxItemsPrefix)}),
+							newSingleLineTagParser("itemsEnum", &setEnum{itemsValidations{ps.Items}, rxf(rxEnumFmt, rxItemsPrefix)}),
 						}
 					}
 
@@ -368,6 +394,7 @@ func (pp *paramStructParser) parseStructType(gofile *ast.File, operation *spec.O
 					sp.taggers = []tagParser{
 						newSingleLineTagParser("required", &matchOnlyParam{&ps, rxRequired}),
 						newSingleLineTagParser("in", &matchOnlyParam{&ps, rxIn}),
+						newMultiLineTagParser("Extensions", newSetExtensions(ps.AddExtension)),
 					}
 				}
 				if err := sp.Parse(fld.Doc); err != nil {
@@ -382,10 +409,12 @@ func (pp *paramStructParser) parseStructType(gofile *ast.File, operation *spec.O
 					ps.AddExtension("x-go-name", gnm)
 				}
 				pt[nm] = ps
+				names = append(names, nm)
 			}
 		}
 
-		for k, p := range pt {
+		for _, k := range names {
+			p := pt[k]
 			for i, v := range operation.Parameters {
 				if v.Name == k {
 					operation.Parameters = append(operation.Parameters[:i], operation.Parameters[i+1:]...)
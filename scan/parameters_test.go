@@ -26,6 +26,12 @@ func TestParamsParser(t *testing.T) {
 	cr, ok := noParamOps["yetAnotherOperation"]
 	assert.True(t, ok)
 	assert.Len(t, cr.Parameters, 6)
+	// the parameters follow the fields, an embedded struct that redeclares a field moves it
+	var names []string
+	for _, param := range cr.Parameters {
+		names = append(names, param.Name)
+	}
+	assert.Equal(t, []string{"age", "id", "name", "notes", "extra", "createdAt"}, names)
 	for _, param := range cr.Parameters {
 		switch param.Name {
 		case "id":
@@ -78,6 +84,7 @@ func TestParamsParser(t *testing.T) {
 
 		case "score":
 			assert.Equal(t, "The Score of this model", param.Description)
+			assert.EqualValues(t, 27, param.Extensions["x-example"])
 			assert.Equal(t, "query", param.In)
 			assert.Equal(t, "number", param.Type)
 			assert.Equal(t, "int32", param.Format)
@@ -165,3 +172,43 @@ func TestParamsParser(t *testing.T) {
 		}
 	}
 }
+
+func TestParamsParser_Enums(t *testing.T) {
+	docFile := "../fixtures/goparsing/classification/operations/enums.go"
+	fileTree, err := goparser.ParseFile(classificationProg.Fset, docFile, nil, goparser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+	sp := newParameterParser(classificationProg)
+	ops := make(map[string]*spec.Operation)
+	if err := sp.Parse(fileTree, ops); err != nil {
+		t.Fatal(err)
+	}
+
+	op, ok := ops["listOrders"]
+	if !assert.True(t, ok) || !assert.Len(t, op.Parameters, 4) {
+		return
+	}
+	params := make(map[string]spec.Parameter)
+	for _, param := range op.Parameters {
+		params[param.Name] = param
+	}
+
+	status := params["status"]
+	assert.Equal(t, "query", status.In)
+	assert.Equal(t, "string", status.Type)
+	assert.Equal(t, []interface{}{"available", "pending", "sold"}, status.Enum)
+
+	statuses := params["statuses"]
+	assert.Equal(t, "array", statuses.Type)
+	if assert.NotNil(t, statuses.Items) {
+		assert.Equal(t, []interface{}{"available", "pending", "sold"}, statuses.Items.Enum)
+	}
+
+	priority := params["X-Priority"]
+	assert.Equal(t, "header", priority.In)
+	assert.Equal(t, "int32", priority.Format)
+	assert.Equal(t, []interface{}{int64(1), int64(2)}, priority.Enum)
+
+	assert.Equal(t, []interface{}{"dog", "cat", "bird"}, params["kind"].Enum)
+}
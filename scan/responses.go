@@ -45,6 +45,14 @@ func (ht responseTypable) Items() swaggerTypable {
 	return itemsTypable{ht.header.Items}
 }
 
+func (ht responseTypable) WithEnum(values ...interface{}) {
+	if ht.in == "body" {
+		ht.Schema().WithEnum(values...)
+		return
+	}
+	ht.header.Enum = append([]interface{}{}, values...)
+}
+
 func (ht responseTypable) SetRef(ref spec.Ref) {
 	// having trouble seeing the usefulness of this one here
 }
@@ -83,6 +91,7 @@ func (sv headerValidations) SetMaxLength(val int64)         { sv.current.MaxLeng
 func (sv headerValidations) SetPattern(val string)          { sv.current.Pattern = val }
 func (sv headerValidations) SetUnique(val bool)             { sv.current.UniqueItems = val }
 func (sv headerValidations) SetCollectionFormat(val string) { sv.current.CollectionFormat = val }
+func (sv headerValidations) SetEnum(val string)             { sv.current.Enum = parseEnum(val, sv.current.Type) }
 
 func newResponseDecl(file *ast.File, decl *ast.GenDecl, ts *ast.TypeSpec) responseDecl {
 	var rd responseDecl
@@ -173,6 +182,15 @@ func (rp *responseParser) parseDecl(responses map[string]spec.Response, decl res
 	response := responses[decl.Name]
 	resPtr := &response
 
+	sp := new(sectionedParser)
+	sp.annotation = &schemaAnnotationParser{GoName: decl.GoName, rx: rxResponseOverride}
+	sp.taggers = []tagParser{
+		newMultiLineTagParser("Extensions", newSetExtensions(resPtr.AddExtension)),
+	}
+	if err := sp.Parse(decl.Decl.Doc); err != nil {
+		return err
+	}
+
 	// analyze struct body for fields etc
 	// each exported struct field:
 	// * gets a type mapped to a go primitive
@@ -192,6 +210,8 @@ func (rp *responseParser) parseDecl(responses map[string]spec.Response, decl res
 
 func (rp *responseParser) parseEmbeddedStruct(gofile *ast.File, response *spec.Response, expr ast.Expr, seenPreviously map[string]struct{}) error {
 	switch tpe := expr.(type) {
+	case *ast.StarExpr:
+		return rp.parseEmbeddedStruct(gofile, response, tpe.X, seenPreviously)
 	case *ast.Ident:
 		// do lookup of type
 		// take primitives into account, they should result in an error for swagger
@@ -206,6 +226,7 @@ func (rp *responseParser) parseEmbeddedStruct(gofile *ast.File, response *spec.R
 		if st, ok := ts.Type.(*ast.StructType); ok {
 			return rp.parseStructType(file, response, st, seenPreviously)
 		}
+		return rp.parseEmbeddedStruct(file, response, ts.Type, seenPreviously)
 	case *ast.SelectorExpr:
 		// look up package, file and then type
 		pkg, err := rp.scp.packageForSelector(gofile, tpe.X)
@@ -219,6 +240,7 @@ func (rp *responseParser) parseEmbeddedStruct(gofile *ast.File, response *spec.R
 		if st, ok := ts.Type.(*ast.StructType); ok {
 			return rp.parseStructType(file, response, st, seenPreviously)
 		}
+		return rp.parseEmbeddedStruct(file, response, ts.Type, seenPreviously)
 	}
 	return fmt.Errorf("unable to resolve embedded struct for: %v\n", expr)
 }
@@ -229,7 +251,7 @@ func (rp *responseParser) parseStructType(gofile *ast.File, response *spec.Respo
 		seenProperties := seenPreviously
 
 		for _, fld := range tpe.Fields.List {
-			if len(fld.Names) == 0 {
+			if len(fld.Names) == 0 && !skipField(fld) {
 				// when the embedded struct is annotated with swagger:allOf it will be used as allOf property
 				// otherwise the fields will just be included as normal properties
 				if err := rp.parseEmbeddedStruct(gofile, response, fld.Type, seenProperties); err != nil {
@@ -239,6 +261,9 @@ func (rp *responseParser) parseStructType(gofile *ast.File, response *spec.Respo
 		}
 
 		for _, fld := range tpe.Fields.List {
+			if skipField(fld) {
+				continue
+			}
 			var nm string
 			if len(fld.Names) > 0 && fld.Names[0] != nil && fld.Names[0].IsExported() {
 				nm = fld.Names[0].Name
@@ -287,6 +312,7 @@ func (rp *responseParser) parseStructType(gofile *ast.File, response *spec.Respo
 					newSingleLineTagParser("minItems", &setMinItems{headerValidations{&ps}, rxf(rxMinItemsFmt, "")}),
 					newSingleLineTagParser("maxItems", &setMaxItems{headerValidations{&ps}, rxf(rxMaxItemsFmt, "")}),
 					newSingleLineTagParser("unique", &setUnique{headerValidations{&ps}, rxf(rxUniqueFmt, "")}),
+					newSingleLineTagParser("enum", &setEnum{headerValidations{&ps}, rxf(rxEnumFmt, "")}),
 				}
 				itemsTaggers := func() []tagParser {
 					return []tagParser{
@@ -300,6 +326,7 @@ func (rp *responseParser) parseStructType(gofile *ast.File, response *spec.Respo
 						newSingleLineTagParser("itemsMinItems", &setMinItems{itemsValidations{ps.Items}, rxf(rxMinItemsFmt, rxItemsPrefix)}),
 						newSingleLineTagParser("itemsMaxItems", &setMaxItems{itemsValidations{ps.Items}, rxf(rxMaxItemsFmt, rxItemsPrefix)}),
 						newSingleLineTagParser("itemsUnique", &setUnique{itemsValidations{ps.Items}, rxf(rxUniqueFmt, rxItemsPrefix)}),
+						newSingleLineTagParser("itemsEnum", &setEnum{itemsValidations{ps.Items}, rxf(rxEnumFmt, rxItemsPrefix)}),
 					}
 				}
 
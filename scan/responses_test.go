@@ -23,6 +23,9 @@ func TestParseResponses(t *testing.T) {
 		t.Fatal(err)
 	}
 	assert.Len(t, responses, 4)
+	ge, ok := responses["genericError"]
+	assert.True(t, ok)
+	assert.Equal(t, "generic", ge.Extensions["x-error-kind"])
 	cr, ok := responses["complexerOne"]
 	assert.True(t, ok)
 	assert.Len(t, cr.Headers, 6)
@@ -144,3 +147,34 @@ func TestParseResponses(t *testing.T) {
 	assert.Equal(t, "Notes to add to this item.\nThis can be used to add special instructions.", iprop.Description)
 
 }
+
+func TestParseResponses_Enums(t *testing.T) {
+	docFile := "../fixtures/goparsing/classification/operations/enums.go"
+	fileTree, err := goparser.ParseFile(classificationProg.Fset, docFile, nil, goparser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+	rp := newResponseParser(classificationProg)
+	responses := make(map[string]spec.Response)
+	if err := rp.Parse(fileTree, responses); err != nil {
+		t.Fatal(err)
+	}
+
+	res, ok := responses["enumResponse"]
+	if !assert.True(t, ok) || !assert.Len(t, res.Headers, 3) {
+		return
+	}
+
+	status := res.Headers["X-Status"]
+	assert.Equal(t, "string", status.Type)
+	assert.Equal(t, []interface{}{"available", "pending", "sold"}, status.Enum)
+
+	priorities := res.Headers["X-Priorities"]
+	assert.Equal(t, "array", priorities.Type)
+	if assert.NotNil(t, priorities.Items) {
+		assert.Equal(t, "int32", priorities.Items.Format)
+		assert.Equal(t, []interface{}{int64(1), int64(2)}, priorities.Items.Enum)
+	}
+
+	assert.Equal(t, []interface{}{"dog", "cat", "bird"}, res.Headers["X-Kind"].Enum)
+}
@@ -47,6 +47,14 @@ type routesParser struct {
 	definitions map[string]spec.Schema
 	operations  map[string]*spec.Operation
 	responses   map[string]spec.Response
+	postDecls   []schemaDecl
+
+	// inferSignatures when true the parameters and responses for a route are also
+	// derived from the function the route comment documents
+	inferSignatures bool
+	// includes the packages the types of the inferred parameters and responses may come from,
+	// without filters it's the package the scan started from and the packages below it
+	includes packageFilters
 }
 
 func (rp *routesParser) Parse(gofile *ast.File, target interface{}) error {
@@ -180,11 +188,20 @@ func (rp *routesParser) Parse(gofile *ast.File, target interface{}) error {
 			newSingleLineTagParser("Schemes", newSetSchemes(opSchemeSetter(op))),
 			newMultiLineTagParser("Security", newSetSecurityDefinitions(opSecurityDefsSetter(op))),
 			newMultiLineTagParser("Responses", sr),
+			newMultiLineTagParser("Extensions", newSetExtensions(op.AddExtension)),
 		}
 		if err := sp.Parse(remaining); err != nil {
 			return fmt.Errorf("operation (%s): %v", op.ID, err)
 		}
 
+		if rp.inferSignatures {
+			if fn := funcForComments(gofile, comsec); fn != nil {
+				if err := rp.inferFromSignature(gofile, fn, op); err != nil {
+					return fmt.Errorf("operation (%s): %v", op.ID, err)
+				}
+			}
+		}
+
 		if tgt.Paths == nil {
 			tgt.Paths = make(map[string]spec.PathItem)
 		}
@@ -193,3 +210,188 @@ func (rp *routesParser) Parse(gofile *ast.File, target interface{}) error {
 
 	return nil
 }
+
+// funcForComments finds the function declaration the comment group documents
+func funcForComments(gofile *ast.File, comments *ast.CommentGroup) *ast.FuncDecl {
+	for _, decl := range gofile.Decls {
+		if fd, ok := decl.(*ast.FuncDecl); ok && fd.Doc == comments {
+			return fd
+		}
+	}
+	return nil
+}
+
+// inferFromSignature fills in the parameters and the success response of an operation
+// from the signature of the function that implements it.
+// The struct arguments of the function become the parameters and the first result that isn't
+// an error becomes the 200 response. What is declared through comments always takes precedence.
+func (rp *routesParser) inferFromSignature(gofile *ast.File, fn *ast.FuncDecl, op *spec.Operation) error {
+	pp := newParameterParser(rp.program)
+	if fn.Type.Params != nil {
+		for _, fld := range fn.Type.Params.List {
+			decl, ok := rp.userType(pp.scp, gofile, fld.Type)
+			if !ok {
+				continue
+			}
+			st, ok := decl.TypeSpec.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+			var inferred spec.Operation
+			if err := pp.parseStructType(decl.File, &inferred, st, make(map[string]spec.Parameter)); err != nil {
+				return err
+			}
+			for _, param := range inferred.Parameters {
+				if !hasParam(op, param.Name, param.In) {
+					op.Parameters = append(op.Parameters, param)
+				}
+			}
+		}
+	}
+	rp.postDecls = append(rp.postDecls, pp.scp.postDecls...)
+
+	if fn.Type.Results == nil {
+		return nil
+	}
+	if op.Responses != nil {
+		if _, ok := op.Responses.StatusCodeResponses[200]; ok {
+			return nil
+		}
+	}
+	for _, fld := range fn.Type.Results.List {
+		if id, ok := fld.Type.(*ast.Ident); ok && id.Name == "error" {
+			continue
+		}
+		resp, err := rp.responseFor(gofile, fld.Type)
+		if err != nil {
+			return err
+		}
+		if resp == nil {
+			continue
+		}
+		if op.Responses == nil {
+			op.Responses = new(spec.Responses)
+		}
+		if op.Responses.StatusCodeResponses == nil {
+			op.Responses.StatusCodeResponses = make(map[int]spec.Response)
+		}
+		op.Responses.StatusCodeResponses[200] = *resp
+		break
+	}
+	return nil
+}
+
+// responseFor builds the response for a result of a handler function.
+// A type annotated with swagger:response is used by reference, any other type becomes the schema of the response.
+func (rp *routesParser) responseFor(gofile *ast.File, expr ast.Expr) (*spec.Response, error) {
+	scp := newSchemaParser(rp.program)
+	decl, ok := rp.userType(scp, gofile, expr)
+	if ok {
+		rd := newResponseDecl(decl.File, decl.Decl, decl.TypeSpec)
+		if rd.hasAnnotation() {
+			if rp.responses == nil {
+				rp.responses = make(map[string]spec.Response)
+			}
+			if _, known := rp.responses[rd.Name]; !known {
+				rsp := newResponseParser(rp.program)
+				if err := rsp.parseDecl(rp.responses, rd); err != nil {
+					return nil, err
+				}
+				rp.postDecls = append(rp.postDecls, rsp.scp.postDecls...)
+			}
+			ref, err := spec.NewRef("#/responses/" + rd.Name)
+			if err != nil {
+				return nil, err
+			}
+			var resp spec.Response
+			resp.Ref = ref
+			return &resp, nil
+		}
+	}
+	if !ok && !rp.inferable(scp, gofile, expr) {
+		return nil, nil
+	}
+
+	schema := new(spec.Schema)
+	if err := parseProperty(scp, gofile, expr, schemaTypable{schema}); err != nil {
+		return nil, err
+	}
+	rp.postDecls = append(rp.postDecls, scp.postDecls...)
+	var resp spec.Response
+	resp.Schema = schema
+	return &resp, nil
+}
+
+// userType resolves the declaration of a named type that is used in a function signature.
+// Only the types from the packages being scanned are resolved, so the arguments from the standard
+// library or a web framework (context.Context, *http.Request, *gin.Context, ...) are left alone.
+func (rp *routesParser) userType(scp *schemaParser, gofile *ast.File, expr ast.Expr) (*schemaDecl, bool) {
+	var pkg *loader.PackageInfo
+	var name string
+	var err error
+	switch tpe := expr.(type) {
+	case *ast.StarExpr:
+		return rp.userType(scp, gofile, tpe.X)
+	case *ast.Ident:
+		name = tpe.Name
+		pkg, err = scp.packageForFile(gofile)
+	case *ast.SelectorExpr:
+		name = tpe.Sel.Name
+		pkg, err = scp.packageForSelector(gofile, tpe.X)
+	default:
+		return nil, false
+	}
+	if err != nil || !rp.scanned(pkg) {
+		return nil, false
+	}
+	file, gd, ts, err := findSourceFile(pkg, name)
+	if err != nil {
+		return nil, false
+	}
+	return newSchemaDecl(file, gd, ts), true
+}
+
+// scanned reports if the package is one of the packages being scanned: the package the scan
+// started from and the packages below it, or the packages matched by the include filters.
+// Vendored packages are never scanned.
+func (rp *routesParser) scanned(pkg *loader.PackageInfo) bool {
+	pth := pkg.Pkg.Path()
+	if strings.HasPrefix(pth, "vendor/") || strings.Contains(pth, "/vendor/") {
+		return false
+	}
+	if rp.includes.HasFilters() {
+		return rp.includes.Matches(pth)
+	}
+	for _, initial := range rp.program.InitialPackages() {
+		root := initial.Pkg.Path()
+		if pth == root || strings.HasPrefix(pth, root+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// inferable reports if a schema can be derived for the type used in a function signature
+func (rp *routesParser) inferable(scp *schemaParser, gofile *ast.File, expr ast.Expr) bool {
+	switch tpe := expr.(type) {
+	case *ast.StarExpr:
+		return rp.inferable(scp, gofile, tpe.X)
+	case *ast.ArrayType:
+		return rp.inferable(scp, gofile, tpe.Elt)
+	case *ast.Ident:
+		if tpe.Obj == nil && swaggerSchemaForType(tpe.Name, schemaTypable{new(spec.Schema)}) == nil {
+			return true
+		}
+	}
+	_, ok := rp.userType(scp, gofile, expr)
+	return ok
+}
+
+func hasParam(op *spec.Operation, name, in string) bool {
+	for _, param := range op.Parameters {
+		if param.Name == name && param.In == in {
+			return true
+		}
+	}
+	return false
+}
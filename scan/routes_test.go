@@ -33,6 +33,8 @@ func TestRoutesParser(t *testing.T) {
 		"This will show all available pets by default.\nYou can get the pets that are out of stock",
 		[]string{"pets", "users"},
 	)
+	assert.Equal(t, true, po.Get.Extensions["x-example-flag"])
+	assert.EqualValues(t, []interface{}{"dog", "cat"}, po.Get.Extensions["x-some-list"])
 	assertOperation(t,
 		po.Post,
 		"createPet",
@@ -112,3 +114,117 @@ func assertOperation(t *testing.T, op *spec.Operation, id, summary, description
 	assert.True(t, ok)
 	assert.Equal(t, "#/responses/validationError", rsp.Ref.String())
 }
+
+func findParam(op *spec.Operation, name string) (spec.Parameter, bool) {
+	for _, p := range op.Parameters {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return spec.Parameter{}, false
+}
+
+func TestRoutesParserInferSignatures(t *testing.T) {
+	doc, err := Run(&Opts{BasePath: "../fixtures/goparsing/handlers", InferSignatures: true})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	pets := doc.Paths.Paths["/pets"]
+	if assert.NotNil(t, pets.Get) {
+		assert.Len(t, pets.Get.Parameters, 2)
+		limit, ok := findParam(pets.Get, "limit")
+		assert.True(t, ok)
+		assert.Equal(t, "query", limit.In)
+		assert.Equal(t, "number", limit.Type)
+		if assert.NotNil(t, limit.Maximum) {
+			assert.EqualValues(t, 100, *limit.Maximum)
+		}
+		tags, ok := findParam(pets.Get, "tags")
+		assert.True(t, ok)
+		assert.Equal(t, "array", tags.Type)
+
+		rsp, ok := pets.Get.Responses.StatusCodeResponses[200]
+		assert.True(t, ok)
+		assert.Equal(t, "#/responses/petList", rsp.Ref.String())
+	}
+	list, ok := doc.Responses["petList"]
+	assert.True(t, ok)
+	if assert.NotNil(t, list.Schema) {
+		assert.Equal(t, "#/definitions/pet", list.Schema.Items.Schema.Ref.String())
+	}
+	_, ok = list.Headers["X-Total-Count"]
+	assert.True(t, ok)
+
+	pet := doc.Paths.Paths["/pets/{id}"]
+	if assert.NotNil(t, pet.Get) {
+		// the request and the context aren't parameters
+		assert.Len(t, pet.Get.Parameters, 1)
+		id, ok := findParam(pet.Get, "id")
+		assert.True(t, ok)
+		assert.Equal(t, "path", id.In)
+		assert.True(t, id.Required)
+
+		rsp, ok := pet.Get.Responses.StatusCodeResponses[200]
+		assert.True(t, ok)
+		if assert.NotNil(t, rsp.Schema) {
+			assert.Equal(t, "#/definitions/pet", rsp.Schema.Ref.String())
+		}
+	}
+	if assert.NotNil(t, pet.Put) {
+		// embedded structs contribute their parameters
+		assert.Len(t, pet.Put.Parameters, 2)
+		body, ok := findParam(pet.Put, "pet")
+		assert.True(t, ok)
+		assert.Equal(t, "body", body.In)
+
+		// the responses from the comments win over the signature
+		rsp, ok := pet.Put.Responses.StatusCodeResponses[200]
+		assert.True(t, ok)
+		assert.Equal(t, "#/responses/petList", rsp.Ref.String())
+	}
+	if assert.NotNil(t, pet.Delete) {
+		assert.Len(t, pet.Delete.Parameters, 1)
+		assert.Nil(t, pet.Delete.Responses)
+	}
+
+	_, ok = doc.Definitions["pet"]
+	assert.True(t, ok)
+}
+
+func TestRoutesParserInferSignatures_Framework(t *testing.T) {
+	doc, err := Run(&Opts{BasePath: "../fixtures/goparsing/handlers", InferSignatures: true})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	orders := doc.Paths.Paths["/orders"]
+	if assert.NotNil(t, orders.Get) {
+		// the context of the framework isn't in the scanned packages, so its fields aren't parameters
+		var names []string
+		for _, param := range orders.Get.Parameters {
+			names = append(names, param.Name)
+		}
+		assert.Equal(t, []string{"status"}, names)
+	}
+	_, ok := doc.Definitions["Param"]
+	assert.False(t, ok)
+}
+
+func TestRoutesParserIgnoresSignatures(t *testing.T) {
+	doc, err := Run(&Opts{BasePath: "../fixtures/goparsing/handlers"})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	pets := doc.Paths.Paths["/pets"]
+	if assert.NotNil(t, pets.Get) {
+		assert.Empty(t, pets.Get.Parameters)
+		assert.Nil(t, pets.Get.Responses)
+	}
+	pet := doc.Paths.Paths["/pets/{id}"]
+	if assert.NotNil(t, pet.Get) {
+		assert.Empty(t, pet.Get.Parameters)
+		assert.Nil(t, pet.Get.Responses)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"go/ast"
 	goparser "go/parser"
-	"log"
 	"regexp"
 	"strings"
 
@@ -32,6 +31,7 @@ const (
 	rxMaxItemsFmt = "%s[Mm]ax(?:imum)?(?:\\p{Zs}*|[\\p{Pd}\\p{Pc}]|\\.)?[Ii]tems\\p{Zs}*:\\p{Zs}*(\\p{N}+)$"
 	rxMinItemsFmt = "%s[Mm]in(?:imum)?(?:\\p{Zs}*|[\\p{Pd}\\p{Pc}]|\\.)?[Ii]tems\\p{Zs}*:\\p{Zs}*(\\p{N}+)$"
 	rxUniqueFmt   = "%s[Uu]nique\\p{Zs}*:\\p{Zs}*(true|false)$"
+	rxEnumFmt     = "%s[Ee]num\\p{Zs}*:\\p{Zs}*(.+)$"
 
 	rxItemsPrefix = "(?:[Ii]tems[\\.\\p{Zs}]?)+"
 )
@@ -41,6 +41,8 @@ var (
 	rxMeta               = regexp.MustCompile("swagger:meta")
 	rxStrFmt             = regexp.MustCompile("swagger:strfmt\\p{Zs}*(\\p{L}[\\p{L}\\p{N}\\p{Pd}\\p{Pc}]+)$")
 	rxAllOf              = regexp.MustCompile("swagger:allOf")
+	rxEnum               = regexp.MustCompile("swagger:enum")
+	rxIgnore             = regexp.MustCompile("swagger:ignore")
 	rxModelOverride      = regexp.MustCompile("swagger:model\\p{Zs}*(\\p{L}[\\p{L}\\p{N}\\p{Pd}\\p{Pc}]+)?$")
 	rxResponseOverride   = regexp.MustCompile("swagger:response\\p{Zs}*(\\p{L}[\\p{L}\\p{N}\\p{Pd}\\p{Pc}]+)?$")
 	rxParametersOverride = regexp.MustCompile("swagger:parameters\\p{Zs}*(\\p{L}[\\p{L}\\p{N}\\p{Pd}\\p{Pc}\\p{Zs}]+)$")
@@ -63,17 +65,19 @@ var (
 	rxStripComments      = regexp.MustCompile("^[^\\w\\+]*")
 	rxStripTitleComments = regexp.MustCompile("^[^\\p{L}]*[Pp]ackage\\p{Zs}+[^\\p{Zs}]+\\p{Zs}*")
 
-	rxConsumes  = regexp.MustCompile("[Cc]onsumes\\p{Zs}*:")
-	rxProduces  = regexp.MustCompile("[Pp]roduces\\p{Zs}*:")
-	rxSecurity  = regexp.MustCompile("[Ss]ecurity\\p{Zs}*:")
-	rxResponses = regexp.MustCompile("[Rr]esponses\\p{Zs}*:")
-	rxSchemes   = regexp.MustCompile("[Ss]chemes\\p{Zs}*:\\p{Zs}*((?:(?:https?|HTTPS?|wss?|WSS?)[\\p{Zs},]*)+)$")
-	rxVersion   = regexp.MustCompile("[Vv]ersion\\p{Zs}*:\\p{Zs}*(.+)$")
-	rxHost      = regexp.MustCompile("[Hh]ost\\p{Zs}*:\\p{Zs}*(.+)$")
-	rxBasePath  = regexp.MustCompile("[Bb]ase\\p{Zs}*-*[Pp]ath\\p{Zs}*:\\p{Zs}*" + rxPath + "$")
-	rxLicense   = regexp.MustCompile("[Ll]icense\\p{Zs}*:\\p{Zs}*(.+)$")
-	rxContact   = regexp.MustCompile("[Cc]ontact\\p{Zs}*-?(?:[Ii]info\\p{Zs}*)?:\\p{Zs}*(.+)$")
-	rxTOS       = regexp.MustCompile("[Tt](:?erms)?\\p{Zs}*-?[Oo]f?\\p{Zs}*-?[Ss](?:ervice)?\\p{Zs}*:")
+	rxConsumes            = regexp.MustCompile("[Cc]onsumes\\p{Zs}*:")
+	rxProduces            = regexp.MustCompile("[Pp]roduces\\p{Zs}*:")
+	rxSecurity            = regexp.MustCompile("[Ss]ecurity\\p{Zs}*:")
+	rxSecurityDefinitions = regexp.MustCompile("[Ss]ecurity\\p{Zs}*[Dd]efinitions\\p{Zs}*:")
+	rxExtensions          = regexp.MustCompile("[Ee]xtensions\\p{Zs}*:")
+	rxResponses           = regexp.MustCompile("[Rr]esponses\\p{Zs}*:")
+	rxSchemes             = regexp.MustCompile("[Ss]chemes\\p{Zs}*:\\p{Zs}*((?:(?:https?|HTTPS?|wss?|WSS?)[\\p{Zs},]*)+)$")
+	rxVersion             = regexp.MustCompile("[Vv]ersion\\p{Zs}*:\\p{Zs}*(.+)$")
+	rxHost                = regexp.MustCompile("[Hh]ost\\p{Zs}*:\\p{Zs}*(.+)$")
+	rxBasePath            = regexp.MustCompile("[Bb]ase\\p{Zs}*-*[Pp]ath\\p{Zs}*:\\p{Zs}*" + rxPath + "$")
+	rxLicense             = regexp.MustCompile("[Ll]icense\\p{Zs}*:\\p{Zs}*(.+)$")
+	rxContact             = regexp.MustCompile("[Cc]ontact\\p{Zs}*-?(?:[Ii]info\\p{Zs}*)?:\\p{Zs}*(.+)$")
+	rxTOS                 = regexp.MustCompile("[Tt](:?erms)?\\p{Zs}*-?[Oo]f?\\p{Zs}*-?[Ss](?:ervice)?\\p{Zs}*:")
 )
 
 // Many thanks go to https://github.com/yvasiyarov/swagger
@@ -108,12 +112,67 @@ func rxf(rxp, ar string) *regexp.Regexp {
 // Similarly the excludes will exclude an item from initial discovery through scanning for annotations.
 // When something in the discovered items requires a type that is contained in the includes or excludes it will still be
 // in the spec.
-func Application(bp string, input *spec.Swagger, includes, excludes packageFilters) (*spec.Swagger, error) {
-	parser, err := newAppScanner(bp, input, includes, excludes)
+// The logger receives progress messages about the files being scanned, when it's nil they are discarded.
+func Application(bp string, input *spec.Swagger, includes, excludes packageFilters, logger swag.Logger) (*spec.Swagger, error) {
+	opts := &Opts{
+		BasePath: bp,
+		Input:    input,
+		Logger:   logger,
+	}
+	for _, pf := range includes {
+		opts.Include = append(opts.Include, pf.Name)
+	}
+	for _, pf := range excludes {
+		opts.Exclude = append(opts.Exclude, pf.Name)
+	}
+	return Run(opts)
+}
+
+// Opts the options for scanning an application
+type Opts struct {
+	// BasePath the package to scan
+	BasePath string
+	// Input the spec to use as starting point, what is discovered gets merged into it
+	Input *spec.Swagger
+	// Merge the strategy for merging what is discovered into the input, defaults to ScannedWins
+	Merge MergeStrategy
+	// Include the packages to discover annotations in, a pattern ending in /... includes the sub packages too
+	Include []string
+	// Exclude the packages to leave out of the discovery, a pattern ending in /... excludes the sub packages too
+	Exclude []string
+	// InferSignatures when true the parameters and responses of a swagger:route
+	// are also derived from the signature of the function it documents
+	InferSignatures bool
+	// Logger receives progress messages and the conflicts with the input, when it's nil they are discarded
+	Logger swag.Logger
+}
+
+// Run scans the application with the provided options and builds a swagger spec for it
+func Run(opts *Opts) (*spec.Swagger, error) {
+	parser, err := newAppScanner(opts.BasePath, nil, newPackageFilters(opts.Include), newPackageFilters(opts.Exclude))
+	if err != nil {
+		return nil, err
+	}
+	if opts.Logger != nil {
+		parser.logger = opts.Logger
+	}
+	parser.inferSignatures = opts.InferSignatures
+	scanned, err := parser.Parse()
 	if err != nil {
 		return nil, err
 	}
-	return parser.Parse()
+	if opts.Input == nil {
+		return scanned, nil
+	}
+
+	conflicts, err := mergeSpecs(opts.Input, scanned, parser.orphanOperations(), opts.Merge)
+	for _, conflict := range conflicts {
+		parser.logger.Printf("conflict with the input spec at %s", conflict)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return opts.Input, nil
 }
 
 // appScanner the global context for scanning a go application
@@ -127,6 +186,9 @@ type appScanner struct {
 	definitions map[string]spec.Schema
 	responses   map[string]spec.Response
 	operations  map[string]*spec.Operation
+	logger      swag.Logger
+
+	inferSignatures bool
 
 	// MainPackage the path to find the main class in
 	MainPackage string
@@ -163,6 +225,7 @@ func newAppScanner(bp string, input *spec.Swagger, includes, excludes packageFil
 		operations:  collectOperationsFromInput(input),
 		definitions: input.Definitions,
 		responses:   input.Responses,
+		logger:      swag.DiscardLogger(),
 		classifier: &programClassifier{
 			Includes: includes,
 			Excludes: excludes,
@@ -200,6 +263,18 @@ func collectOperationsFromInput(input *spec.Swagger) map[string]*spec.Operation
 	return operations
 }
 
+// orphanOperations the operations that got parameters but aren't declared by any route
+func (a *appScanner) orphanOperations() map[string]*spec.Operation {
+	placed := collectOperationsFromInput(a.input)
+	orphans := make(map[string]*spec.Operation)
+	for id, op := range a.operations {
+		if _, ok := placed[id]; !ok {
+			orphans[id] = op
+		}
+	}
+	return orphans
+}
+
 // Parse produces a swagger object for an application
 func (a *appScanner) Parse() (*spec.Swagger, error) {
 	// classification still includes files that are completely commented out
@@ -210,6 +285,7 @@ func (a *appScanner) Parse() (*spec.Swagger, error) {
 
 	// build parameters dictionary
 	for _, paramsFile := range cp.Parameters {
+		a.fileLogger(paramsFile).Printf("scanning parameters")
 		if err := a.parseParameters(paramsFile); err != nil {
 			return nil, err
 		}
@@ -217,6 +293,7 @@ func (a *appScanner) Parse() (*spec.Swagger, error) {
 
 	// build responses dictionary
 	for _, responseFile := range cp.Responses {
+		a.fileLogger(responseFile).Printf("scanning responses")
 		if err := a.parseResponses(responseFile); err != nil {
 			return nil, err
 		}
@@ -229,13 +306,20 @@ func (a *appScanner) Parse() (*spec.Swagger, error) {
 
 	// build paths dictionary
 	for _, routeFile := range cp.Operations {
+		a.fileLogger(routeFile).Printf("scanning routes")
 		if err := a.parseRoutes(routeFile); err != nil {
 			return nil, err
 		}
 	}
 
+	// routes can discover models through the signatures of their handlers
+	if err := a.processDiscovered(); err != nil {
+		return nil, err
+	}
+
 	// build swagger object
 	for _, metaFile := range cp.Meta {
+		a.fileLogger(metaFile).Printf("scanning meta")
 		if err := a.parseMeta(metaFile); err != nil {
 			return nil, err
 		}
@@ -266,6 +350,7 @@ func (a *appScanner) processDiscovered() error {
 }
 
 func (a *appScanner) parseSchema(file *ast.File) error {
+	a.fileLogger(file).Printf("scanning models")
 	sp := newSchemaParser(a.prog)
 	if err := sp.Parse(file, a.definitions); err != nil {
 		return err
@@ -279,9 +364,12 @@ func (a *appScanner) parseRoutes(file *ast.File) error {
 	rp.operations = a.operations
 	rp.definitions = a.definitions
 	rp.responses = a.responses
+	rp.inferSignatures = a.inferSignatures
+	rp.includes = a.classifier.Includes
 	if err := rp.Parse(file, a.input.Paths); err != nil {
 		return err
 	}
+	a.discovered = append(a.discovered, rp.postDecls...)
 	return nil
 }
 
@@ -309,11 +397,16 @@ func (a *appScanner) parseMeta(file *ast.File) error {
 	return newMetaParser(a.input).Parse(file.Doc)
 }
 
-// MustExpandPackagePath gets the real package path on disk
+func (a *appScanner) fileLogger(file *ast.File) swag.Logger {
+	return a.logger.WithFields(swag.Fields{"file": a.prog.Fset.Position(file.Pos()).Filename})
+}
+
+// MustExpandPackagePath gets the real package path on disk, it panics when the package can't be found
 func (a *appScanner) MustExpandPackagePath(packagePath string) string {
 	pkgRealpath := swag.FindInGoSearchPath(packagePath)
 	if pkgRealpath == "" {
-		log.Fatalf("Can't find package %s \n", packagePath)
+		a.logger.Printf("can't find package %s", packagePath)
+		panic(fmt.Sprintf("can't find package %s", packagePath))
 	}
 
 	return pkgRealpath
@@ -324,6 +417,7 @@ type swaggerTypable interface {
 	SetRef(spec.Ref)
 	Items() swaggerTypable
 	Schema() *spec.Schema
+	WithEnum(...interface{})
 }
 
 func swaggerSchemaForType(typeName string, prop swaggerTypable) error {
@@ -381,6 +475,12 @@ type tagParser struct {
 	Parser    valueParser
 }
 
+// an indentedParser receives the lines of its section with their indentation intact
+type indentedParser interface {
+	valueParser
+	Indented() bool
+}
+
 func (st *tagParser) Matches(line string) bool {
 	return st.Parser.Matches(line)
 }
@@ -420,9 +520,38 @@ func (st *sectionedParser) cleanup(lines []string) []string {
 			lastContent = i
 		}
 	}
+	if seenLine < 0 {
+		return nil
+	}
 	return uncommented[seenLine : lastContent+1]
 }
 
+// uncommentIndented strips the comment markers from the lines but keeps
+// their indentation relative to the least indented line
+func uncommentIndented(lines []string) []string {
+	var uncommented []string
+	indent := -1
+	for _, line := range lines {
+		str := strings.TrimSuffix(strings.TrimRight(line, " "), "*/")
+		if trimmed := strings.TrimSpace(str); strings.HasPrefix(trimmed, "//") || strings.HasPrefix(trimmed, "/*") {
+			str = trimmed[2:]
+		}
+		str = strings.TrimRight(strings.Replace(str, "\t", "  ", -1), " ")
+		if trimmed := strings.TrimLeft(str, " "); trimmed != "" {
+			if lead := len(str) - len(trimmed); indent < 0 || lead < indent {
+				indent = lead
+			}
+		}
+		uncommented = append(uncommented, str)
+	}
+	for i, line := range uncommented {
+		if len(line) >= indent && indent > 0 {
+			uncommented[i] = line[indent:]
+		}
+	}
+	return uncommented
+}
+
 func (st *sectionedParser) collectTitleDescription() {
 	if st.workedOutTitle {
 		return
@@ -538,7 +667,13 @@ COMMENTS:
 		st.setDescription(st.Description())
 	}
 	for _, mt := range st.matched {
-		if err := mt.Parse(st.cleanup(mt.Lines)); err != nil {
+		lines := mt.Lines
+		if ip, ok := mt.Parser.(indentedParser); ok && ip.Indented() {
+			lines = uncommentIndented(lines)
+		} else {
+			lines = st.cleanup(lines)
+		}
+		if err := mt.Parse(lines); err != nil {
 			return err
 		}
 	}
@@ -1,6 +1,7 @@
 package scan
 
 import (
+	"encoding/json"
 	"fmt"
 	"go/ast"
 	goparser "go/parser"
@@ -43,6 +44,23 @@ func TestAppScanner_NewSpec(t *testing.T) {
 	}
 }
 
+func TestAppScanner_StableOutput(t *testing.T) {
+	var docs [][]byte
+	for i := 0; i < 3; i++ {
+		doc, err := Run(&Opts{BasePath: "../fixtures/goparsing/classification"})
+		if !assert.NoError(t, err) {
+			return
+		}
+		b, err := json.Marshal(doc)
+		if !assert.NoError(t, err) {
+			return
+		}
+		docs = append(docs, b)
+	}
+	assert.Equal(t, string(docs[0]), string(docs[1]))
+	assert.Equal(t, string(docs[0]), string(docs[2]))
+}
+
 func verifyParsedPetStore(t testing.TB, doc *spec.Swagger) {
 	assert.EqualValues(t, []string{"application/json"}, doc.Consumes)
 	assert.EqualValues(t, []string{"application/json"}, doc.Produces)
@@ -3,6 +3,9 @@ package scan
 import (
 	"fmt"
 	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -29,6 +32,10 @@ func (st schemaTypable) Schema() *spec.Schema {
 	return st.schema
 }
 
+func (st schemaTypable) WithEnum(values ...interface{}) {
+	st.schema.WithEnum(values...)
+}
+
 func (st schemaTypable) Items() swaggerTypable {
 	if st.schema.Items == nil {
 		st.schema.Items = new(spec.SchemaOrArray)
@@ -60,6 +67,13 @@ func (sv schemaValidations) SetMinLength(val int64)    { sv.current.MinLength =
 func (sv schemaValidations) SetMaxLength(val int64)    { sv.current.MaxLength = &val }
 func (sv schemaValidations) SetPattern(val string)     { sv.current.Pattern = val }
 func (sv schemaValidations) SetUnique(val bool)        { sv.current.UniqueItems = val }
+func (sv schemaValidations) SetEnum(val string) {
+	var tpe string
+	if len(sv.current.Type) > 0 {
+		tpe = sv.current.Type[0]
+	}
+	sv.current.Enum = parseEnum(val, tpe)
+}
 
 func newSchemaAnnotationParser(goName string) *schemaAnnotationParser {
 	return &schemaAnnotationParser{GoName: goName, rx: rxModelOverride}
@@ -180,6 +194,9 @@ func (scp *schemaParser) parseDecl(definitions map[string]spec.Schema, decl *sch
 	// once type name is found convert it to a schema, by looking up the schema in the
 	// definitions dictionary that got passed into this parse method
 	decl.inferNames()
+	if isIgnored(decl.Decl.Doc) {
+		return nil
+	}
 	schema := definitions[decl.Name]
 	schPtr := &schema
 
@@ -187,6 +204,10 @@ func (scp *schemaParser) parseDecl(definitions map[string]spec.Schema, decl *sch
 	sp := new(sectionedParser)
 	sp.setTitle = func(lines []string) { schema.Title = joinDropLast(lines) }
 	sp.setDescription = func(lines []string) { schema.Description = joinDropLast(lines) }
+	sp.annotation = newSchemaAnnotationParser(decl.GoName)
+	sp.taggers = []tagParser{
+		newMultiLineTagParser("Extensions", newSetExtensions(schPtr.AddExtension)),
+	}
 	if err := sp.Parse(decl.Decl.Doc); err != nil {
 		return err
 	}
@@ -222,6 +243,9 @@ func (scp *schemaParser) parseDecl(definitions map[string]spec.Schema, decl *sch
 
 func (scp *schemaParser) parseEmbeddedStruct(gofile *ast.File, schema *spec.Schema, expr ast.Expr, seenPreviously map[string]struct{}) error {
 	switch tpe := expr.(type) {
+	case *ast.StarExpr:
+		return scp.parseEmbeddedStruct(gofile, schema, tpe.X, seenPreviously)
+
 	case *ast.Ident:
 		// do lookup of type
 		// take primitives into account, they should result in an error for swagger
@@ -236,6 +260,8 @@ func (scp *schemaParser) parseEmbeddedStruct(gofile *ast.File, schema *spec.Sche
 		if st, ok := ts.Type.(*ast.StructType); ok {
 			return scp.parseStructType(file, schema, st, seenPreviously)
 		}
+		// a named type for a struct declared elsewhere
+		return scp.parseEmbeddedStruct(file, schema, ts.Type, seenPreviously)
 
 	case *ast.SelectorExpr:
 		// look up package, file and then type
@@ -250,6 +276,7 @@ func (scp *schemaParser) parseEmbeddedStruct(gofile *ast.File, schema *spec.Sche
 		if st, ok := ts.Type.(*ast.StructType); ok {
 			return scp.parseStructType(file, schema, st, seenPreviously)
 		}
+		return scp.parseEmbeddedStruct(file, schema, ts.Type, seenPreviously)
 	}
 	return fmt.Errorf("unable to resolve embedded struct for: %v\n", expr)
 }
@@ -263,6 +290,9 @@ func (scp *schemaParser) parseAllOfMember(gofile *ast.File, schema *spec.Schema,
 	var err error
 
 	switch tpe := expr.(type) {
+	case *ast.StarExpr:
+		return scp.parseAllOfMember(gofile, schema, tpe.X, seenPreviously)
+
 	case *ast.Ident:
 		// do lookup of type
 		// take primitives into account, they should result in an error for swagger
@@ -312,7 +342,7 @@ func (scp *schemaParser) parseStructType(gofile *ast.File, bschema *spec.Schema,
 		seenProperties := seenPreviously
 
 		for _, fld := range tpe.Fields.List {
-			if len(fld.Names) == 0 {
+			if len(fld.Names) == 0 && jsonName(fld) == "" && !skipField(fld) {
 				// if this created an allOf property then we have to rejig the schema var
 				// because all the fields collected that aren't from embedded structs should go in
 				// their own proper schema
@@ -354,28 +384,27 @@ func (scp *schemaParser) parseStructType(gofile *ast.File, bschema *spec.Schema,
 		}
 		schema.Typed("object", "")
 		for _, fld := range tpe.Fields.List {
-			if len(fld.Names) > 0 && fld.Names[0] != nil && fld.Names[0].IsExported() {
-				var nm, gnm string
-				nm = fld.Names[0].Name
-				gnm = nm
-				if fld.Tag != nil && len(strings.TrimSpace(fld.Tag.Value)) > 0 {
-					tv, err := strconv.Unquote(fld.Tag.Value)
-					if err != nil {
-						return err
-					}
-
-					if strings.TrimSpace(tv) != "" {
-						st := reflect.StructTag(tv)
-						if st.Get("json") != "" {
-							nm = strings.Split(st.Get("json"), ",")[0]
-						}
-					}
+			if gnm := fieldName(fld); gnm != "" {
+				if skipField(fld) || scp.ignoredType(gofile, fld.Type) {
+					continue
+				}
+				tag, err := parseJSONTag(fld)
+				if err != nil {
+					return err
+				}
+				nm := gnm
+				if tag.Name != "" {
+					nm = tag.Name
 				}
 
 				ps := schema.Properties[nm]
 				if err := parseProperty(scp, gofile, fld.Type, schemaTypable{&ps}); err != nil {
 					return err
 				}
+				// the string option encodes numbers and booleans as json strings
+				if tag.String && (ps.Type.Contains("number") || ps.Type.Contains("integer") || ps.Type.Contains("boolean")) {
+					ps.Type = spec.StringOrArray([]string{"string"})
+				}
 
 				sp := new(sectionedParser)
 				sp.setDescription = func(lines []string) { ps.Description = joinDropLast(lines) }
@@ -390,8 +419,10 @@ func (scp *schemaParser) parseStructType(gofile *ast.File, bschema *spec.Schema,
 						newSingleLineTagParser("minItems", &setMinItems{schemaValidations{&ps}, rxf(rxMinItemsFmt, "")}),
 						newSingleLineTagParser("maxItems", &setMaxItems{schemaValidations{&ps}, rxf(rxMaxItemsFmt, "")}),
 						newSingleLineTagParser("unique", &setUnique{schemaValidations{&ps}, rxf(rxUniqueFmt, "")}),
+						newSingleLineTagParser("enum", &setEnum{schemaValidations{&ps}, rxf(rxEnumFmt, "")}),
 						newSingleLineTagParser("required", &setRequiredSchema{schema, nm}),
 						newSingleLineTagParser("readOnly", &setReadOnlySchema{&ps}),
+						newMultiLineTagParser("Extensions", newSetExtensions(ps.AddExtension)),
 					}
 
 					// check if this is a primitive, if so parse the validations from the
@@ -409,6 +440,7 @@ func (scp *schemaParser) parseStructType(gofile *ast.File, bschema *spec.Schema,
 									newSingleLineTagParser("itemsMinItems", &setMinItems{schemaValidations{ps.Items.Schema}, rxf(rxMinItemsFmt, rxItemsPrefix)}),
 									newSingleLineTagParser("itemsMaxItems", &setMaxItems{schemaValidations{ps.Items.Schema}, rxf(rxMaxItemsFmt, rxItemsPrefix)}),
 									newSingleLineTagParser("itemsUnique", &setUnique{schemaValidations{ps.Items.Schema}, rxf(rxUniqueFmt, rxItemsPrefix)}),
+									newSingleLineTagParser("itemsEnum", &setEnum{schemaValidations{ps.Items.Schema}, rxf(rxEnumFmt, rxItemsPrefix)}),
 								}
 
 								// items matchers should go before the default matchers so they match first
@@ -419,12 +451,23 @@ func (scp *schemaParser) parseStructType(gofile *ast.File, bschema *spec.Schema,
 				} else {
 					sp.taggers = []tagParser{
 						newSingleLineTagParser("required", &setRequiredSchema{schema, nm}),
+						newMultiLineTagParser("Extensions", newSetExtensions(ps.AddExtension)),
 					}
 				}
 				if err := sp.Parse(fld.Doc); err != nil {
 					return err
 				}
 
+				// a field that is omitted when empty can't be required
+				if tag.OmitEmpty {
+					for i, req := range schema.Required {
+						if req == nm {
+							schema.Required = append(schema.Required[:i], schema.Required[i+1:]...)
+							break
+						}
+					}
+				}
+
 				if nm != gnm {
 					ps.AddExtension("x-go-name", gnm)
 				}
@@ -455,6 +498,7 @@ func (scp *schemaParser) packageForFile(gofile *ast.File) (*loader.PackageInfo,
 func (scp *schemaParser) packageForSelector(gofile *ast.File, expr ast.Expr) (*loader.PackageInfo, error) {
 
 	if pth, ok := expr.(*ast.Ident); ok {
+		importer := scp.importerOf(gofile)
 		// lookup import
 		var selPath string
 		for _, imp := range gofile.Imports {
@@ -468,6 +512,10 @@ func (scp *schemaParser) packageForSelector(gofile *ast.File, expr ast.Expr) (*l
 					break
 				}
 			} else {
+				if pkg := scp.importedPackage(importer, pv); pkg != nil && pkg.Pkg.Name() == pth.Name {
+					selPath = pv
+					break
+				}
 				parts := strings.Split(pv, "/")
 				if len(parts) > 0 && parts[len(parts)-1] == pth.Name {
 					selPath = pv
@@ -480,7 +528,7 @@ func (scp *schemaParser) packageForSelector(gofile *ast.File, expr ast.Expr) (*l
 			return nil, fmt.Errorf("no import found for %s", pth.Name)
 		}
 
-		pkg := scp.program.Package(selPath)
+		pkg := scp.importedPackage(importer, selPath)
 		if pkg == nil {
 			return nil, fmt.Errorf("no package found for %s", selPath)
 		}
@@ -489,6 +537,52 @@ func (scp *schemaParser) packageForSelector(gofile *ast.File, expr ast.Expr) (*l
 	return nil, fmt.Errorf("can't determine selector path from %v", expr)
 }
 
+// importerOf the path of the package the file belongs to, or an empty string when the file
+// isn't part of the program
+func (scp *schemaParser) importerOf(gofile *ast.File) string {
+	for pkg, pkgInfo := range scp.program.AllPackages {
+		for _, file := range pkgInfo.Files {
+			if file == gofile {
+				return pkg.Path()
+			}
+		}
+	}
+	return ""
+}
+
+// importedPackage finds the package for an import path like the go tool does, the vendor folders
+// are searched from the directory of the importing package outward before the path itself.
+// When none of those is known, the vendored package with the longest path is used, so the
+// result doesn't depend on the order of the packages.
+func (scp *schemaParser) importedPackage(importer, path string) *loader.PackageInfo {
+	dir := importer
+	for dir != "" {
+		if pkg := scp.program.Package(dir + "/vendor/" + path); pkg != nil {
+			return pkg
+		}
+		if idx := strings.LastIndex(dir, "/"); idx >= 0 {
+			dir = dir[:idx]
+		} else {
+			dir = ""
+		}
+	}
+	if pkg := scp.program.Package(path); pkg != nil {
+		return pkg
+	}
+
+	var found *loader.PackageInfo
+	for pkg, pkgInfo := range scp.program.AllPackages {
+		if !strings.HasSuffix(pkg.Path(), "/vendor/"+path) {
+			continue
+		}
+		if found == nil || len(pkg.Path()) > len(found.Pkg.Path()) ||
+			(len(pkg.Path()) == len(found.Pkg.Path()) && pkg.Path() < found.Pkg.Path()) {
+			found = pkgInfo
+		}
+	}
+	return found
+}
+
 func (scp *schemaParser) parseIdentProperty(pkg *loader.PackageInfo, expr *ast.Ident, prop swaggerTypable) error {
 	// find the file this selector points to
 	file, gd, ts, err := findSourceFile(pkg, expr.Name)
@@ -526,7 +620,7 @@ func (scp *schemaParser) parseIdentProperty(pkg *loader.PackageInfo, expr *ast.I
 		case *ast.SelectorExpr:
 			return scp.typeForSelector(file, atpe, prop.Items())
 		default:
-			return fmt.Errorf("unknown selector type: %#v", tpe)
+			return parseProperty(scp, file, atpe, prop.Items())
 		}
 	case *ast.StructType:
 		sd := newSchemaDecl(file, gd, ts)
@@ -540,22 +634,94 @@ func (scp *schemaParser) parseIdentProperty(pkg *loader.PackageInfo, expr *ast.I
 		return nil
 
 	case *ast.Ident:
-		return scp.parseIdentProperty(pkg, tpe, prop)
+		if err := scp.parseIdentProperty(pkg, tpe, prop); err != nil {
+			return err
+		}
+		// a type annotated with swagger:enum gets the constants declared for it as enum
+		if isEnum(gd.Doc) {
+			values, err := enumValues(pkg, ts.Name.Name)
+			if err != nil {
+				return err
+			}
+			prop.WithEnum(values...)
+		}
+		return nil
 
 	case *ast.SelectorExpr:
 		return scp.typeForSelector(file, tpe, prop)
 
+	case *ast.StarExpr, *ast.MapType:
+		return parseProperty(scp, file, tpe, prop)
+
+	case *ast.InterfaceType:
+		// an interface can be anything
+		return nil
+
 	default:
 		return swaggerSchemaForType(expr.Name, prop)
 	}
 
 }
 
+// enumValues collects the values of the constants declared with the named type, in declaration order
+func enumValues(pkg *loader.PackageInfo, typeName string) ([]interface{}, error) {
+	obj := pkg.Pkg.Scope().Lookup(typeName)
+	if obj == nil {
+		return nil, fmt.Errorf("unable to find %s in %s", typeName, pkg.String())
+	}
+
+	var values []interface{}
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.CONST {
+				continue
+			}
+			for _, spc := range gd.Specs {
+				vs, ok := spc.(*ast.ValueSpec)
+				if !ok {
+					continue
+				}
+				for _, nm := range vs.Names {
+					cnst, ok := pkg.Defs[nm].(*types.Const)
+					if !ok || !types.Identical(cnst.Type(), obj.Type()) {
+						continue
+					}
+					values = append(values, constantValue(cnst.Val()))
+				}
+			}
+		}
+	}
+	return values, nil
+}
+
+func constantValue(val constant.Value) interface{} {
+	switch val.Kind() {
+	case constant.String:
+		return constant.StringVal(val)
+	case constant.Bool:
+		return constant.BoolVal(val)
+	case constant.Int:
+		if i, ok := constant.Int64Val(val); ok {
+			return i
+		}
+	case constant.Float:
+		if f, ok := constant.Float64Val(val); ok {
+			return f
+		}
+	}
+	return val.ExactString()
+}
+
 func (scp *schemaParser) typeForSelector(gofile *ast.File, expr *ast.SelectorExpr, prop swaggerTypable) error {
 	pkg, err := scp.packageForSelector(gofile, expr.X)
 	if err != nil {
 		return err
 	}
+	if pkg.Pkg.Path() == "time" && expr.Sel.Name == "Time" {
+		prop.Typed("string", "date-time")
+		return nil
+	}
 
 	return scp.parseIdentProperty(pkg, expr.Sel, prop)
 }
@@ -578,6 +744,120 @@ func findSourceFile(pkg *loader.PackageInfo, typeName string) (*ast.File, *ast.G
 	return nil, nil, nil, fmt.Errorf("unable to find %s in %s", typeName, pkg.String())
 }
 
+// jsonTag the options encoding/json reads from the json struct tag of a field
+type jsonTag struct {
+	Name      string
+	Skip      bool
+	OmitEmpty bool
+	String    bool
+}
+
+func parseJSONTag(fld *ast.Field) (jsonTag, error) {
+	var tag jsonTag
+	if fld.Tag == nil || len(strings.TrimSpace(fld.Tag.Value)) == 0 {
+		return tag, nil
+	}
+	tv, err := strconv.Unquote(fld.Tag.Value)
+	if err != nil {
+		return tag, err
+	}
+	jv := reflect.StructTag(tv).Get("json")
+	if jv == "-" {
+		tag.Skip = true
+		return tag, nil
+	}
+	parts := strings.Split(jv, ",")
+	tag.Name = parts[0]
+	for _, opt := range parts[1:] {
+		switch strings.TrimSpace(opt) {
+		case "omitempty":
+			tag.OmitEmpty = true
+		case "string":
+			tag.String = true
+		}
+	}
+	return tag, nil
+}
+
+// jsonName the name from the json struct tag of a field
+func jsonName(fld *ast.Field) string {
+	tag, _ := parseJSONTag(fld)
+	return tag.Name
+}
+
+// skipField reports if a field is left out of the spec, either because
+// encoding/json skips it or because it's annotated with swagger:ignore
+func skipField(fld *ast.Field) bool {
+	tag, err := parseJSONTag(fld)
+	return (err == nil && tag.Skip) || isIgnored(fld.Doc)
+}
+
+// ignoredType reports if the type used for a field is annotated with swagger:ignore
+func (scp *schemaParser) ignoredType(gofile *ast.File, expr ast.Expr) bool {
+	var pkg *loader.PackageInfo
+	var name string
+	var err error
+	switch tpe := expr.(type) {
+	case *ast.StarExpr:
+		return scp.ignoredType(gofile, tpe.X)
+	case *ast.ArrayType:
+		return scp.ignoredType(gofile, tpe.Elt)
+	case *ast.MapType:
+		return scp.ignoredType(gofile, tpe.Value)
+	case *ast.Ident:
+		name = tpe.Name
+		pkg, err = scp.packageForFile(gofile)
+	case *ast.SelectorExpr:
+		name = tpe.Sel.Name
+		pkg, err = scp.packageForSelector(gofile, tpe.X)
+	default:
+		return false
+	}
+	if err != nil {
+		return false
+	}
+	_, gd, _, err := findSourceFile(pkg, name)
+	return err == nil && isIgnored(gd.Doc)
+}
+
+func isIgnored(comments *ast.CommentGroup) bool {
+	if comments != nil {
+		for _, cmt := range comments.List {
+			for _, ln := range strings.Split(cmt.Text, "\n") {
+				if rxIgnore.MatchString(ln) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// fieldName the go name of an exported field, an embedded field only has one
+// when its json tag turns it into a property of its own
+func fieldName(fld *ast.Field) string {
+	if len(fld.Names) > 0 {
+		if fld.Names[0] != nil && fld.Names[0].IsExported() {
+			return fld.Names[0].Name
+		}
+		return ""
+	}
+	if jsonName(fld) == "" {
+		return ""
+	}
+	expr := fld.Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	switch tpe := expr.(type) {
+	case *ast.Ident:
+		return tpe.Name
+	case *ast.SelectorExpr:
+		return tpe.Sel.Name
+	}
+	return ""
+}
+
 func allOfMember(comments *ast.CommentGroup) bool {
 	if comments != nil {
 		for _, cmt := range comments.List {
@@ -591,6 +871,19 @@ func allOfMember(comments *ast.CommentGroup) bool {
 	return false
 }
 
+func isEnum(comments *ast.CommentGroup) bool {
+	if comments != nil {
+		for _, cmt := range comments.List {
+			for _, ln := range strings.Split(cmt.Text, "\n") {
+				if rxEnum.MatchString(ln) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 func strfmtName(comments *ast.CommentGroup) (string, bool) {
 	if comments != nil {
 		for _, cmt := range comments.List {
@@ -615,7 +908,7 @@ func parseProperty(scp *schemaParser, gofile *ast.File, fld ast.Expr, prop swagg
 		return scp.parseIdentProperty(pkg, ftpe, prop)
 
 	case *ast.StarExpr: // pointer to something, optional by default
-		parseProperty(scp, gofile, ftpe.X, prop)
+		return parseProperty(scp, gofile, ftpe.X, prop)
 
 	case *ast.ArrayType: // slice type
 		if err := parseProperty(scp, gofile, ftpe.Elt, prop.Items()); err != nil {
@@ -649,7 +942,9 @@ func parseProperty(scp *schemaParser, gofile *ast.File, fld ast.Expr, prop swagg
 				if sch.AdditionalProperties.Schema == nil {
 					sch.AdditionalProperties.Schema = new(spec.Schema)
 				}
-				parseProperty(scp, gofile, ftpe.Value, schemaTypable{sch.AdditionalProperties.Schema})
+				if err := parseProperty(scp, gofile, ftpe.Value, schemaTypable{sch.AdditionalProperties.Schema}); err != nil {
+					return err
+				}
 				sch.Typed("object", "")
 			}
 		}
@@ -1,11 +1,13 @@
 package scan
 
 import (
+	goparser "go/parser"
 	"path/filepath"
 	"testing"
 
 	"github.com/casualjim/go-swagger/spec"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/tools/go/loader"
 )
 
 func TestSchemaParser(t *testing.T) {
@@ -365,3 +367,241 @@ func TestParseMapFields(t *testing.T) {
 	assertMapRef(t, &schema, "ptrTops", "PtrTops", "#/definitions/Something")
 	assertMapRef(t, &schema, "ptrNotSels", "PtrNotSels", "#/definitions/NotSelected")
 }
+
+func TestEnums(t *testing.T) {
+	docFile := "../fixtures/goparsing/classification/models/enums.go"
+	fileTree, err := goparser.ParseFile(classificationProg.Fset, docFile, nil, goparser.ParseComments)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	sp := newSchemaParser(classificationProg)
+	defs := make(map[string]spec.Schema)
+	if !assert.NoError(t, sp.Parse(fileTree, defs)) {
+		t.FailNow()
+	}
+
+	schema := defs["EnumModel"]
+	assertProperty(t, &schema, "string", "status", "", "Status")
+	assert.Equal(t, []interface{}{"available", "pending", "sold"}, schema.Properties["status"].Enum)
+
+	assertProperty(t, &schema, "number", "priority", "int32", "Priority")
+	assert.Equal(t, []interface{}{int64(1), int64(2)}, schema.Properties["priority"].Enum)
+
+	assertArrayProperty(t, &schema, "string", "statuses", "", "Statuses")
+	assert.Equal(t, []interface{}{"available", "pending", "sold"}, schema.Properties["statuses"].Items.Schema.Enum)
+
+	assert.Equal(t, []interface{}{"dog", "cat", "bird"}, schema.Properties["kind"].Enum)
+	assert.Equal(t, []interface{}{int64(1), int64(2), int64(3)}, schema.Properties["size"].Enum)
+	assert.Equal(t, []interface{}{"red", "green", "blue"}, schema.Properties["colors"].Items.Schema.Enum)
+}
+
+func TestEmbeddedAndCrossPackageTypes(t *testing.T) {
+	docFile := "../fixtures/goparsing/classification/models/embedded.go"
+	fileTree, err := goparser.ParseFile(classificationProg.Fset, docFile, nil, goparser.ParseComments)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	definitions := make(map[string]spec.Schema)
+	sp := newSchemaParser(classificationProg)
+	if !assert.NoError(t, sp.Parse(fileTree, definitions)) {
+		return
+	}
+
+	schema := definitions["EmbeddedPointers"]
+	// embedded pointers are inlined
+	assertProperty(t, &schema, "number", "did", "int64", "DID")
+	assertProperty(t, &schema, "string", "cat", "", "Cat")
+	assertProperty(t, &schema, "string", "notes", "", "Notes")
+	assertProperty(t, &schema, "string", "extra", "", "Extra")
+
+	// unless they are named through a json tag
+	assertRef(t, &schema, "selected", "NotSelected", "#/definitions/NotSelected")
+	_, ok := schema.Properties["id"]
+	assert.False(t, ok)
+
+	assertProperty(t, &schema, "string", "stamp", "date-time", "Stamp")
+	assertRef(t, &schema, "owner", "Owner", "#/definitions/pet")
+
+	prop, ok := schema.Properties["friends"]
+	if assert.True(t, ok) && assert.NotNil(t, prop.Items) {
+		assert.Equal(t, "#/definitions/pet", prop.Items.Schema.Ref.String())
+	}
+	prop, ok = schema.Properties["siblings"]
+	if assert.True(t, ok) && assert.NotNil(t, prop.Items) {
+		assert.True(t, prop.Type.Contains("array"))
+		assert.Equal(t, "#/definitions/pet", prop.Items.Schema.Ref.String())
+	}
+	for _, nm := range []string{"byName", "named"} {
+		prop, ok = schema.Properties[nm]
+		if assert.True(t, ok, nm) && assert.NotNil(t, prop.AdditionalProperties, nm) {
+			assert.True(t, prop.Type.Contains("object"), nm)
+			assert.Equal(t, "#/definitions/pet", prop.AdditionalProperties.Schema.Ref.String(), nm)
+		}
+	}
+	prop, ok = schema.Properties["labels"]
+	if assert.True(t, ok) && assert.NotNil(t, prop.AdditionalProperties) {
+		assert.True(t, prop.AdditionalProperties.Schema.Type.Contains("string"))
+	}
+	prop, ok = schema.Properties["matrix"]
+	if assert.True(t, ok) && assert.NotNil(t, prop.Items) && assert.NotNil(t, prop.Items.Schema.Items) {
+		assert.Equal(t, "#/definitions/Category", prop.Items.Schema.Items.Schema.Ref.String())
+	}
+	_, ok = schema.Properties["anything"]
+	assert.True(t, ok)
+
+	var names []string
+	for _, decl := range sp.postDecls {
+		names = append(names, decl.Name)
+	}
+	assert.Contains(t, names, "pet")
+	assert.Contains(t, names, "Category")
+	assert.Contains(t, names, "NotSelected")
+}
+
+func TestJSONTagsAndIgnore(t *testing.T) {
+	docFile := "../fixtures/goparsing/classification/models/ignored.go"
+	fileTree, err := goparser.ParseFile(classificationProg.Fset, docFile, nil, goparser.ParseComments)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	definitions := make(map[string]spec.Schema)
+	sp := newSchemaParser(classificationProg)
+	if !assert.NoError(t, sp.Parse(fileTree, definitions)) {
+		return
+	}
+
+	_, ok := definitions["Secret"]
+	assert.False(t, ok)
+
+	schema := definitions["JSONTagged"]
+	assertProperty(t, &schema, "string", "id", "int64", "ID")
+	assertProperty(t, &schema, "string", "name", "", "Name")
+	assertProperty(t, &schema, "number", "age", "int32", "Age")
+	prop, ok := schema.Properties["Active"]
+	if assert.True(t, ok) {
+		assert.EqualValues(t, []string{"string"}, prop.Type)
+	}
+	assertProperty(t, &schema, "string", "-", "", "Dash")
+	assert.EqualValues(t, []string{"id", "age"}, schema.Required)
+
+	for _, nm := range []string{"Internal", "hidden", "token", "tokens"} {
+		_, ok := schema.Properties[nm]
+		assert.False(t, ok, nm)
+	}
+	assert.Empty(t, sp.postDecls)
+}
+
+func TestSchemaExtensions(t *testing.T) {
+	docFile := "../fixtures/goparsing/classification/models/extensions.go"
+	fileTree, err := goparser.ParseFile(classificationProg.Fset, docFile, nil, goparser.ParseComments)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	definitions := make(map[string]spec.Schema)
+	sp := newSchemaParser(classificationProg)
+	if !assert.NoError(t, sp.Parse(fileTree, definitions)) {
+		return
+	}
+
+	schema, ok := definitions["extended"]
+	if !assert.True(t, ok) {
+		return
+	}
+	assert.Equal(t, "An Extended model carries vendor extensions.", schema.Title)
+	assert.Equal(t, "Extended", schema.Extensions["x-go-type"])
+	assert.Equal(t, map[string]interface{}{"limit": float64(10), "period": "1m"}, schema.Extensions["x-rate-limit"])
+
+	assert.EqualValues(t, []string{"id"}, schema.Required)
+	assert.EqualValues(t, 1, schema.Properties["id"].Extensions["x-order"])
+	owner := schema.Properties["owner"]
+	assert.Equal(t, true, owner.Extensions["x-nullable"])
+	assert.Equal(t, "#/definitions/Something", owner.Ref.String())
+}
+
+func TestVendoredPackage(t *testing.T) {
+	// the vendored package is created under its vendor path, like the loader finds it in a gopath
+	const fixtures = "../fixtures/goparsing/vendored"
+	const pkgPath = "github.com/casualjim/go-swagger/fixtures/goparsing/vendored"
+	var ldr loader.Config
+	ldr.ParserMode = goparser.ParseComments
+	ldr.AllowErrors = true
+	ldr.TypeChecker.Error = func(error) {}
+	ldr.CreateFromFilenames(pkgPath, filepath.Join(fixtures, "store.go"))
+	ldr.CreateFromFilenames(pkgPath+"/vendor/github.com/example/go-pets", filepath.Join(fixtures, "vendor/github.com/example/go-pets/pets.go"))
+	prog, err := ldr.Load()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	sp := newSchemaParser(prog)
+	pkg := sp.importedPackage(pkgPath, "github.com/example/go-pets")
+	if assert.NotNil(t, pkg) {
+		assert.Equal(t, "pets", pkg.Pkg.Name())
+	}
+	assert.Nil(t, sp.importedPackage(pkgPath, "github.com/example/cats"))
+
+	definitions := make(map[string]spec.Schema)
+	if !assert.NoError(t, sp.Parse(prog.Created[0].Files[0], definitions)) {
+		return
+	}
+	schema := definitions["Store"]
+	assertRef(t, &schema, "pet", "Pet", "#/definitions/Pet")
+	assertArrayRef(t, &schema, "pets", "Pets", "#/definitions/Pet")
+
+	var names []string
+	for _, decl := range sp.postDecls {
+		names = append(names, decl.Name)
+	}
+	assert.Contains(t, names, "Pet")
+}
+
+func TestVendoredPackage_Nested(t *testing.T) {
+	// the shop has its own vendor folder inside the one of the store, the nearest one wins
+	const fixtures = "../fixtures/goparsing/vendored"
+	const pkgPath = "github.com/casualjim/go-swagger/fixtures/goparsing/vendored"
+	const petsPath = "github.com/example/go-pets"
+	var ldr loader.Config
+	ldr.ParserMode = goparser.ParseComments
+	ldr.AllowErrors = true
+	ldr.TypeChecker.Error = func(error) {}
+	ldr.CreateFromFilenames(pkgPath, filepath.Join(fixtures, "store.go"))
+	ldr.CreateFromFilenames(pkgPath+"/vendor/"+petsPath, filepath.Join(fixtures, "vendor", petsPath, "pets.go"))
+	ldr.CreateFromFilenames(pkgPath+"/shop", filepath.Join(fixtures, "shop", "shop.go"))
+	ldr.CreateFromFilenames(pkgPath+"/shop/vendor/"+petsPath, filepath.Join(fixtures, "shop", "vendor", petsPath, "pets.go"))
+	prog, err := ldr.Load()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	sp := newSchemaParser(prog)
+	for i := 0; i < 10; i++ {
+		if pkg := sp.importedPackage(pkgPath+"/shop", petsPath); assert.NotNil(t, pkg) {
+			assert.Equal(t, pkgPath+"/shop/vendor/"+petsPath, pkg.Pkg.Path())
+		}
+		if pkg := sp.importedPackage(pkgPath, petsPath); assert.NotNil(t, pkg) {
+			assert.Equal(t, pkgPath+"/vendor/"+petsPath, pkg.Pkg.Path())
+		}
+		// without an importer the choice doesn't depend on the order of the packages
+		if pkg := sp.importedPackage("", petsPath); assert.NotNil(t, pkg) {
+			assert.Equal(t, pkgPath+"/shop/vendor/"+petsPath, pkg.Pkg.Path())
+		}
+	}
+
+	definitions := make(map[string]spec.Schema)
+	if !assert.NoError(t, sp.Parse(prog.Created[2].Files[0], definitions)) {
+		return
+	}
+	schema := definitions["Shop"]
+	assertRef(t, &schema, "pet", "Pet", "#/definitions/Pet")
+	var found bool
+	for _, decl := range sp.postDecls {
+		if decl.Name == "Pet" {
+			found = true
+			assert.Equal(t, prog.Created[3].Files[0], decl.File)
+		}
+	}
+	assert.True(t, found)
+}
@@ -21,6 +21,7 @@ type validationBuilder interface {
 	SetPattern(string)
 
 	SetUnique(bool)
+	SetEnum(string)
 }
 
 type valueParser interface {
@@ -260,6 +261,56 @@ func (su *setUnique) Parse(lines []string) error {
 	return nil
 }
 
+type setEnum struct {
+	builder validationBuilder
+	rx      *regexp.Regexp
+}
+
+func (se *setEnum) Matches(line string) bool {
+	return se.rx.MatchString(line)
+}
+
+func (se *setEnum) Parse(lines []string) error {
+	if len(lines) == 0 || (len(lines) == 1 && len(lines[0]) == 0) {
+		return nil
+	}
+	matches := se.rx.FindStringSubmatch(lines[0])
+	if len(matches) > 1 && len(matches[1]) > 0 {
+		se.builder.SetEnum(matches[1])
+	}
+	return nil
+}
+
+// parseEnum splits a comma separated list of enum values and converts
+// the values to the swagger type they're declared for
+func parseEnum(val string, tpe string) []interface{} {
+	var values []interface{}
+	for _, v := range strings.Split(val, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		switch tpe {
+		case "integer", "number":
+			if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+				values = append(values, i)
+				continue
+			}
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				values = append(values, f)
+				continue
+			}
+		case "boolean":
+			if b, err := strconv.ParseBool(v); err == nil {
+				values = append(values, b)
+				continue
+			}
+		}
+		values = append(values, v)
+	}
+	return values
+}
+
 type matchOnlyParam struct {
 	tgt *spec.Parameter
 	rx  *regexp.Regexp
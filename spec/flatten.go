@@ -0,0 +1,294 @@
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/casualjim/go-swagger/jsonpointer"
+	"github.com/casualjim/go-swagger/swag"
+)
+
+// Flatten loads the spec at the path and bundles every document it refers to into a single spec.
+//
+// The remote and relative refs are imported into the definitions, parameters or responses of the spec,
+// depending on where the ref is used, and get rewritten to local refs. A ref that points into one of those
+// sections keeps the name it has there, otherwise the name of the file is used. When the name is already taken
+// a number is added to it.
+//
+// Unlike Expanded, the local refs are kept so the names of the definitions don't get lost.
+func Flatten(path string) (*Document, error) {
+	rootURL, err := documentURL(path)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &flattener{
+		docs:     make(map[string]interface{}),
+		imported: make(map[string]string),
+	}
+	doc, err := f.load(rootURL)
+	if err != nil {
+		return nil, err
+	}
+	root, ok := doc.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected the spec at %q to be an object", path)
+	}
+	f.root = root
+	f.rootURL = rootURL
+
+	if _, err := f.walk(root, rootURL, nil); err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(root)
+	if err != nil {
+		return nil, err
+	}
+	return New(data, "")
+}
+
+type flattener struct {
+	root    map[string]interface{}
+	rootURL *url.URL
+	// docs the documents that were loaded, by url
+	docs map[string]interface{}
+	// imported the local ref for every remote ref that got imported already
+	imported map[string]string
+}
+
+func documentURL(pth string) (*url.URL, error) {
+	if strings.HasPrefix(pth, "http://") || strings.HasPrefix(pth, "https://") {
+		return url.Parse(pth)
+	}
+	abs, err := filepath.Abs(pth)
+	if err != nil {
+		return nil, err
+	}
+	return &url.URL{Path: filepath.ToSlash(abs)}, nil
+}
+
+func (f *flattener) load(u *url.URL) (interface{}, error) {
+	docURL := *u
+	docURL.Fragment = ""
+	key := docURL.String()
+	if doc, ok := f.docs[key]; ok {
+		return doc, nil
+	}
+
+	location := docURL.String()
+	if docURL.Scheme == "" {
+		location = filepath.FromSlash(docURL.Path)
+	}
+	var data json.RawMessage
+	var err error
+	if ext := path.Ext(docURL.Path); ext == ".yaml" || ext == ".yml" {
+		data, err = swag.YAMLDoc(location)
+	} else {
+		data, err = swag.JSONDoc(location)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	f.docs[key] = doc
+	return doc, nil
+}
+
+// resolve gets a copy of the value the ref points to, the copy can be changed without changing the loaded document
+func (f *flattener) resolve(ref *url.URL) (interface{}, error) {
+	doc, err := f.load(ref)
+	if err != nil {
+		return nil, err
+	}
+	ptr, err := jsonpointer.New(ref.Fragment)
+	if err != nil {
+		return nil, err
+	}
+	value, _, err := ptr.Get(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var cp interface{}
+	if err := json.Unmarshal(b, &cp); err != nil {
+		return nil, err
+	}
+	return cp, nil
+}
+
+func (f *flattener) isRoot(u *url.URL) bool {
+	return u.Scheme == f.rootURL.Scheme && u.Host == f.rootURL.Host && u.Path == f.rootURL.Path
+}
+
+// walk rewrites the refs in the node, base is the url of the document the node comes from
+// and location the path to the node in the root document
+func (f *flattener) walk(node interface{}, base *url.URL, location []string) (interface{}, error) {
+	switch nd := node.(type) {
+	case map[string]interface{}:
+		if ref, ok := nd["$ref"].(string); ok {
+			return f.walkRef(nd, ref, base, location)
+		}
+		for _, k := range sortedKeys(nd) {
+			v, err := f.walk(nd[k], base, append(location, k))
+			if err != nil {
+				return nil, err
+			}
+			nd[k] = v
+		}
+	case []interface{}:
+		for i, v := range nd {
+			v, err := f.walk(v, base, append(location, strconv.Itoa(i)))
+			if err != nil {
+				return nil, err
+			}
+			nd[i] = v
+		}
+	}
+	return node, nil
+}
+
+func (f *flattener) walkRef(node map[string]interface{}, ref string, base *url.URL, location []string) (interface{}, error) {
+	refURL, err := url.Parse(ref)
+	if err != nil {
+		return nil, err
+	}
+	target := base.ResolveReference(refURL)
+	if f.isRoot(target) {
+		node["$ref"] = "#" + target.Fragment
+		return node, nil
+	}
+	if local, ok := f.imported[target.String()]; ok {
+		node["$ref"] = local
+		return node, nil
+	}
+
+	section := sectionFor(location)
+	if section == "" {
+		// there is no section for this kind of object, so it gets inlined
+		value, err := f.resolve(target)
+		if err != nil {
+			return nil, err
+		}
+		return f.walk(value, target, location)
+	}
+
+	var name string
+	if len(location) == 2 && location[0] == section {
+		// the entry of a section is a ref, so the imported value takes its place
+		name = location[1]
+	} else {
+		name = f.uniqueName(section, importName(target))
+	}
+	local := "#/" + section + "/" + jsonpointer.Escape(name)
+	f.imported[target.String()] = local
+
+	value, err := f.resolve(target)
+	if err != nil {
+		return nil, err
+	}
+	value, err = f.walk(value, target, []string{section, name})
+	if err != nil {
+		return nil, err
+	}
+
+	entries, ok := f.root[section].(map[string]interface{})
+	if !ok {
+		entries = make(map[string]interface{})
+		f.root[section] = entries
+	}
+	entries[name] = value
+	if len(location) == 2 && location[0] == section {
+		return value, nil
+	}
+	node["$ref"] = local
+	return node, nil
+}
+
+func (f *flattener) uniqueName(section, name string) string {
+	entries, _ := f.root[section].(map[string]interface{})
+	taken := func(nm string) bool {
+		if _, ok := entries[nm]; ok {
+			return true
+		}
+		for _, local := range f.imported {
+			if local == "#/"+section+"/"+jsonpointer.Escape(nm) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if !taken(name) {
+		return name
+	}
+	for i := 1; ; i++ {
+		if nm := name + strconv.Itoa(i); !taken(nm) {
+			return nm
+		}
+	}
+}
+
+// importName the name for an imported ref, the last part of the pointer or the name of the file
+func importName(ref *url.URL) string {
+	if ptr, err := jsonpointer.New(ref.Fragment); err == nil {
+		if tokens := ptr.DecodedTokens(); len(tokens) > 0 {
+			return tokens[len(tokens)-1]
+		}
+	}
+	base := path.Base(ref.Path)
+	return strings.TrimSuffix(base, path.Ext(base))
+}
+
+// sectionFor the section of the spec that can hold the object at the location,
+// it's empty for objects that can't be referred to from a section, like path items
+func sectionFor(location []string) string {
+	switch {
+	case len(location) == 0:
+		return ""
+	case location[0] == "parameters" && len(location) == 2:
+		return "parameters"
+	case location[0] == "responses" && len(location) == 2:
+		return "responses"
+	case location[0] == "paths":
+		switch len(location) {
+		case 2:
+			return ""
+		case 4:
+			if location[2] == "parameters" {
+				return "parameters"
+			}
+		case 5:
+			if location[3] == "parameters" {
+				return "parameters"
+			}
+			if location[3] == "responses" {
+				return "responses"
+			}
+		}
+	}
+	return "definitions"
+}
+
+func sortedKeys(data map[string]interface{}) []string {
+	var keys []string
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
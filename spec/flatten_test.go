@@ -0,0 +1,64 @@
+package spec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlatten(t *testing.T) {
+	doc, err := Flatten("../fixtures/flatten/swagger.yaml")
+	if !assert.NoError(t, err) {
+		return
+	}
+	sp := doc.Spec()
+
+	// the path item is inlined
+	pets := sp.Paths.Paths["/pets"]
+	if assert.NotNil(t, pets.Get) {
+		assert.Equal(t, "listPets", pets.Get.ID)
+		if assert.Len(t, pets.Get.Parameters, 1) {
+			assert.Equal(t, "#/parameters/limit", pets.Get.Parameters[0].Ref.String())
+		}
+		rsp := pets.Get.Responses.StatusCodeResponses[200]
+		assert.Equal(t, "#/definitions/pet1", rsp.Schema.Items.Schema.Ref.String())
+		assert.Equal(t, "#/responses/error", pets.Get.Responses.Default.Ref.String())
+	}
+
+	pet := sp.Paths.Paths["/pets/{id}"]
+	if assert.NotNil(t, pet.Get) {
+		assert.Equal(t, "#/parameters/petId", pet.Get.Parameters[0].Ref.String())
+		rsp := pet.Get.Responses.StatusCodeResponses[200]
+		assert.Equal(t, "#/definitions/pet1", rsp.Schema.Ref.String())
+	}
+
+	// the local definition keeps its name, the imported one gets a number
+	assert.Equal(t, "a local model with the same name as a remote one", sp.Definitions["pet"].Description)
+	imported, ok := sp.Definitions["pet1"]
+	if assert.True(t, ok) {
+		tags, parent := imported.Properties["tags"], imported.Properties["parent"]
+		assert.Equal(t, "#/definitions/tag", tags.Items.Schema.Ref.String())
+		assert.Equal(t, "#/definitions/pet1", parent.Ref.String())
+	}
+	// a definition that is a ref gets replaced with what it refers to
+	tag, ok := sp.Definitions["tag"]
+	if assert.True(t, ok) {
+		assert.Equal(t, "", tag.Ref.String())
+		assert.Contains(t, tag.Properties, "name")
+	}
+	// the local refs of an imported document are imported too
+	errResponse, ok := sp.Responses["error"]
+	if assert.True(t, ok) {
+		assert.Equal(t, "#/definitions/error", errResponse.Schema.Ref.String())
+	}
+	_, ok = sp.Definitions["error"]
+	assert.True(t, ok)
+
+	assert.Len(t, sp.Parameters, 2)
+	assert.Equal(t, "id", sp.Parameters["petId"].Name)
+}
+
+func TestFlattenMissingDocument(t *testing.T) {
+	_, err := Flatten("../fixtures/flatten/no-such-file.yaml")
+	assert.Error(t, err)
+}
@@ -2,6 +2,8 @@ package spec
 
 import (
 	"encoding/json"
+	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/casualjim/go-swagger/jsonpointer"
@@ -51,6 +53,37 @@ func (v vendorExtensible) MarshalJSON() ([]byte, error) {
 	return json.Marshal(toser)
 }
 
+// JSONSet sets an extension by the json property name, a nil value removes it
+func (v *vendorExtensible) JSONSet(token string, value interface{}) error {
+	if !strings.HasPrefix(strings.ToLower(token), "x-") {
+		return fmt.Errorf("object has no field %q", token)
+	}
+	if value == nil {
+		delete(v.Extensions, token)
+		return nil
+	}
+	if v.Extensions == nil {
+		v.Extensions = make(map[string]interface{})
+	}
+	v.Extensions[token] = value
+	return nil
+}
+
+// setJSONValue stores the value in the target, which is a pointer. A value of another type
+// is converted through its json representation, like the generic json from a patch
+func setJSONValue(target, value interface{}) error {
+	tv := reflect.ValueOf(target).Elem()
+	val := reflect.ValueOf(value)
+	if val.Kind() == reflect.Ptr && !val.IsNil() && val.Elem().Type() == tv.Type() {
+		val = val.Elem()
+	}
+	if val.Type().AssignableTo(tv.Type()) {
+		tv.Set(val)
+		return nil
+	}
+	return swag.DynamicJSONToStruct(value, target)
+}
+
 func (v *vendorExtensible) UnmarshalJSON(data []byte) error {
 	var d map[string]interface{}
 	if err := json.Unmarshal(data, &d); err != nil {
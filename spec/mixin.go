@@ -0,0 +1,216 @@
+package spec
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/casualjim/go-swagger/jsonpointer"
+)
+
+// Collision is a value that a mixin declares differently than the primary spec,
+// the value of the mixin is skipped
+type Collision struct {
+	// Mixin is the position of the mixin in the mixins, starting at 1
+	Mixin int
+	// Location is the json pointer to the value in the mixin
+	Location string
+}
+
+func (c Collision) String() string {
+	return fmt.Sprintf("mixin %d: %s", c.Mixin, c.Location)
+}
+
+// Mixin adds the paths, definitions, parameters, responses, security definitions and tags
+// of the mixins to the primary spec.
+//
+// Nothing in the primary spec gets overwritten, when a mixin declares the same path and method,
+// a different object under the same name or an operation id that is already used, it's
+// skipped and reported as a collision. Objects that are the same in both specs aren't collisions.
+// The ref, the extensions and the parameters of a path that both specs declare are merged the same
+// way, the parameters are matched by their location and name.
+func Mixin(primary *Swagger, mixins ...*Swagger) []Collision {
+	var collisions []Collision
+	for i, mixin := range mixins {
+		m := &mixer{primary: primary, mixin: i + 1}
+		m.paths(mixin)
+		MergeMap("/definitions", &primary.Definitions, mixin.Definitions, m.skip)
+		MergeMap("/parameters", &primary.Parameters, mixin.Parameters, m.skip)
+		MergeMap("/responses", &primary.Responses, mixin.Responses, m.skip)
+		MergeMap("/securityDefinitions", &primary.SecurityDefinitions, mixin.SecurityDefinitions, m.skip)
+		m.tags(mixin)
+		collisions = append(collisions, m.collisions...)
+	}
+	return collisions
+}
+
+// MergeMap merges the entries of a map into another map, into is a pointer to a map with string keys
+// and from is a map of the same type. The entries that are missing get added, and when an entry holds
+// a different value resolve decides: the value from the map gets used when it returns true.
+// Resolve gets the json pointer of the entry, which is the location of the map with the key appended.
+// The keys are merged in alphabetical order.
+func MergeMap(location string, into, from interface{}, resolve func(location string, existing, value interface{}) bool) {
+	src := reflect.ValueOf(from)
+	if src.Len() == 0 {
+		return
+	}
+	dst := reflect.ValueOf(into).Elem()
+	if dst.IsNil() {
+		dst.Set(reflect.MakeMap(dst.Type()))
+	}
+
+	var names []string
+	for _, key := range src.MapKeys() {
+		names = append(names, key.String())
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		key := reflect.ValueOf(name).Convert(src.Type().Key())
+		value := src.MapIndex(key)
+		if existing := dst.MapIndex(key); existing.IsValid() {
+			if reflect.DeepEqual(existing.Interface(), value.Interface()) {
+				continue
+			}
+			if !resolve(location+"/"+jsonpointer.Escape(name), existing.Interface(), value.Interface()) {
+				continue
+			}
+		}
+		dst.SetMapIndex(key, value)
+	}
+}
+
+type mixer struct {
+	primary    *Swagger
+	mixin      int
+	collisions []Collision
+}
+
+func (m *mixer) collide(location string) {
+	m.collisions = append(m.collisions, Collision{Mixin: m.mixin, Location: location})
+}
+
+// skip keeps the value of the primary spec and reports the collision
+func (m *mixer) skip(location string, _, _ interface{}) bool {
+	m.collide(location)
+	return false
+}
+
+func (m *mixer) paths(mixin *Swagger) {
+	if mixin.Paths == nil {
+		return
+	}
+	if m.primary.Paths == nil {
+		m.primary.Paths = new(Paths)
+	}
+	if m.primary.Paths.Paths == nil {
+		m.primary.Paths.Paths = make(map[string]PathItem)
+	}
+
+	operationIDs := make(map[string]struct{})
+	for _, item := range m.primary.Paths.Paths {
+		for _, ptr := range item.Operations() {
+			if op := *ptr; op != nil && op.ID != "" {
+				operationIDs[op.ID] = struct{}{}
+			}
+		}
+	}
+
+	var keys []string
+	for k := range mixin.Paths.Paths {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, pth := range keys {
+		mixinItem := mixin.Paths.Paths[pth]
+		location := "/paths/" + jsonpointer.Escape(pth)
+		item, exists := m.primary.Paths.Paths[pth]
+		if exists {
+			m.pathItem(location, &item, mixinItem)
+		} else {
+			item.refable = mixinItem.refable
+			item.vendorExtensible = mixinItem.vendorExtensible
+			item.Parameters = mixinItem.Parameters
+		}
+
+		primaryOps, mixinOps := item.Operations(), mixinItem.Operations()
+		methods := make([]string, 0, len(mixinOps))
+		for method := range mixinOps {
+			methods = append(methods, method)
+		}
+		sort.Strings(methods)
+		for _, method := range methods {
+			op := *mixinOps[method]
+			if op == nil {
+				continue
+			}
+			if existing := *primaryOps[method]; existing != nil {
+				if !reflect.DeepEqual(existing, op) {
+					m.collide(location + "/" + method)
+				}
+				continue
+			}
+			if _, ok := operationIDs[op.ID]; ok && op.ID != "" {
+				m.collide(location + "/" + method + "/operationId")
+				continue
+			}
+			if op.ID != "" {
+				operationIDs[op.ID] = struct{}{}
+			}
+			*primaryOps[method] = op
+		}
+		m.primary.Paths.Paths[pth] = item
+	}
+}
+
+// pathItem merges the ref, the extensions and the parameters of a path item the primary spec
+// already has, the parameters are matched by their location and name or by their ref
+func (m *mixer) pathItem(location string, item *PathItem, mixinItem PathItem) {
+	if ref := mixinItem.Ref.String(); ref != "" {
+		if item.Ref.String() == "" {
+			item.Ref = mixinItem.Ref
+		} else if item.Ref.String() != ref {
+			m.collide(location + "/$ref")
+		}
+	}
+	MergeMap(location, &item.Extensions, mixinItem.Extensions, m.skip)
+
+	key := func(param Parameter) string {
+		if ref := param.Ref.String(); ref != "" {
+			return ref
+		}
+		return param.In + "/" + param.Name
+	}
+	for i, param := range mixinItem.Parameters {
+		found := false
+		for _, existing := range item.Parameters {
+			if key(existing) == key(param) {
+				found = true
+				if !reflect.DeepEqual(existing, param) {
+					m.collide(fmt.Sprintf("%s/parameters/%d", location, i))
+				}
+				break
+			}
+		}
+		if !found {
+			item.Parameters = append(item.Parameters, param)
+		}
+	}
+}
+
+func (m *mixer) tags(mixin *Swagger) {
+	for i, tag := range mixin.Tags {
+		found := false
+		for _, existing := range m.primary.Tags {
+			if existing.Name == tag.Name {
+				found = true
+				if !reflect.DeepEqual(existing, tag) {
+					m.collide(fmt.Sprintf("/tags/%d", i))
+				}
+				break
+			}
+		}
+		if !found {
+			m.primary.Tags = append(m.primary.Tags, tag)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package spec
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mixinSpec(t testing.TB, data string) *Swagger {
+	sp := new(Swagger)
+	if err := json.Unmarshal([]byte(data), sp); err != nil {
+		t.Fatal(err)
+	}
+	return sp
+}
+
+func TestMixin(t *testing.T) {
+	primary := mixinSpec(t, `{
+		"paths": {"/pets": {"get": {"operationId": "listPets"}}},
+		"definitions": {"pet": {"type": "object"}, "error": {"type": "string"}},
+		"tags": [{"name": "pets"}]
+	}`)
+	orders := mixinSpec(t, `{
+		"paths": {
+			"/pets": {"get": {"operationId": "listPets"}, "post": {"operationId": "addPet"}},
+			"/orders": {"get": {"operationId": "listOrders"}}
+		},
+		"definitions": {"order": {"type": "object"}, "error": {"type": "string"}},
+		"parameters": {"limit": {"name": "limit", "in": "query", "type": "integer"}},
+		"responses": {"notFound": {"description": "not found"}},
+		"securityDefinitions": {"api_key": {"type": "apiKey", "name": "X-API-KEY", "in": "header"}},
+		"tags": [{"name": "pets"}, {"name": "orders"}]
+	}`)
+	users := mixinSpec(t, `{
+		"paths": {
+			"/pets": {"get": {"operationId": "findPets"}},
+			"/users": {"get": {"operationId": "listOrders"}, "post": {"operationId": "addUser"}}
+		},
+		"definitions": {"pet": {"type": "string"}},
+		"tags": [{"name": "pets", "description": "the pets"}]
+	}`)
+
+	collisions := Mixin(primary, orders, users)
+	assert.Equal(t, []Collision{
+		{Mixin: 2, Location: "/paths/~1pets/get"},
+		{Mixin: 2, Location: "/paths/~1users/get/operationId"},
+		{Mixin: 2, Location: "/definitions/pet"},
+		{Mixin: 2, Location: "/tags/0"},
+	}, collisions)
+	assert.Equal(t, "mixin 2: /paths/~1pets/get", collisions[0].String())
+
+	assert.Len(t, primary.Paths.Paths, 3)
+	assert.Equal(t, "listPets", primary.Paths.Paths["/pets"].Get.ID)
+	assert.Equal(t, "addPet", primary.Paths.Paths["/pets"].Post.ID)
+	assert.Nil(t, primary.Paths.Paths["/users"].Get)
+	assert.Equal(t, "addUser", primary.Paths.Paths["/users"].Post.ID)
+
+	assert.Len(t, primary.Definitions, 3)
+	assert.Equal(t, StringOrArray{"object"}, primary.Definitions["pet"].Type)
+	assert.Contains(t, primary.Parameters, "limit")
+	assert.Contains(t, primary.Responses, "notFound")
+	assert.Contains(t, primary.SecurityDefinitions, "api_key")
+	if assert.Len(t, primary.Tags, 2) {
+		assert.Equal(t, "orders", primary.Tags[1].Name)
+		assert.Empty(t, primary.Tags[0].Description)
+	}
+}
+
+func TestMixin_PathItem(t *testing.T) {
+	primary := mixinSpec(t, `{
+		"paths": {"/pets/{id}": {
+			"x-owner": "pets",
+			"parameters": [
+				{"name": "id", "in": "path", "required": true, "type": "integer"},
+				{"$ref": "#/parameters/trace"}
+			],
+			"get": {"operationId": "getPet"}
+		}}
+	}`)
+	mixin := mixinSpec(t, `{
+		"paths": {"/pets/{id}": {
+			"$ref": "pets.json#/pet",
+			"x-owner": "orders",
+			"x-team": "core",
+			"parameters": [
+				{"name": "id", "in": "path", "required": true, "type": "string"},
+				{"$ref": "#/parameters/trace"},
+				{"name": "X-Request-ID", "in": "header", "type": "string"}
+			],
+			"delete": {"operationId": "deletePet"}
+		}}
+	}`)
+
+	collisions := Mixin(primary, mixin)
+	assert.Equal(t, []Collision{
+		{Mixin: 1, Location: "/paths/~1pets~1{id}/x-owner"},
+		{Mixin: 1, Location: "/paths/~1pets~1{id}/parameters/0"},
+	}, collisions)
+
+	item := primary.Paths.Paths["/pets/{id}"]
+	assert.Equal(t, "pets.json#/pet", item.Ref.String())
+	assert.Equal(t, "pets", item.Extensions["x-owner"])
+	assert.Equal(t, "core", item.Extensions["x-team"])
+	if assert.Len(t, item.Parameters, 3) {
+		assert.Equal(t, "integer", item.Parameters[0].Type)
+		assert.Equal(t, "X-Request-ID", item.Parameters[2].Name)
+	}
+	assert.Equal(t, "deletePet", item.Delete.ID)
+
+	// a different ref is a collision
+	collisions = Mixin(primary, mixinSpec(t, `{"paths": {"/pets/{id}": {"$ref": "other.json#/pet"}}}`))
+	assert.Equal(t, []Collision{{Mixin: 1, Location: "/paths/~1pets~1{id}/$ref"}}, collisions)
+}
+
+func TestMergeMap(t *testing.T) {
+	var into map[string]Parameter
+	from := map[string]Parameter{
+		"limit": *QueryParam("limit").Typed("integer", "int32"),
+		"a/b":   *QueryParam("a/b"),
+	}
+	MergeMap("/parameters", &into, from, func(string, interface{}, interface{}) bool {
+		t.Fatal("no entry to resolve")
+		return false
+	})
+	assert.Equal(t, from, into)
+
+	from = map[string]Parameter{
+		"a/b":    *QueryParam("a/b").Typed("string", ""),
+		"limit":  *QueryParam("limit").Typed("integer", "int32"),
+		"offset": *QueryParam("offset"),
+	}
+	var locations []string
+	MergeMap("/parameters", &into, from, func(location string, existing, value interface{}) bool {
+		locations = append(locations, location)
+		assert.Empty(t, existing.(Parameter).Type)
+		assert.Equal(t, "string", value.(Parameter).Type)
+		return true
+	})
+	assert.Equal(t, []string{"/parameters/a~1b"}, locations)
+	assert.Equal(t, "string", into["a/b"].Type)
+	assert.Contains(t, into, "offset")
+}
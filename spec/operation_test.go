@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
 )
 
 var operation = Operation{
@@ -97,3 +98,43 @@ func TestIntegrationOperation(t *testing.T) {
 	})
 
 }
+
+func TestOperationBuilder(t *testing.T) {
+	op := NewOperation("getPet").
+		WithSummary("gets a pet").
+		WithTags("pets").
+		WithProduces("application/json").
+		WithPathParam("id", "integer", "int64").
+		WithQueryParam("fields", "string", "").
+		SecuredWith("api_key").
+		RespondsWith(200, NewResponse().WithDescription("the pet").WithSchema(RefProperty("#/definitions/pet"))).
+		WithDefaultResponse(ResponseRef("#/responses/error"))
+
+	b, err := json.Marshal(op)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{
+			"operationId": "getPet",
+			"summary": "gets a pet",
+			"tags": ["pets"],
+			"produces": ["application/json"],
+			"security": [{"api_key": []}],
+			"parameters": [
+				{"name": "id", "in": "path", "required": true, "type": "integer", "format": "int64"},
+				{"name": "fields", "in": "query", "type": "string"}
+			],
+			"responses": {
+				"200": {"description": "the pet", "schema": {"$ref": "#/definitions/pet"}},
+				"default": {"$ref": "#/responses/error"}
+			}
+		}`, string(b))
+	}
+
+	op.AddParam(QueryParam("fields").Typed("array", "").WithDescription("the fields to include"))
+	if assert.Len(t, op.Parameters, 2) {
+		assert.Equal(t, "array", op.Parameters[1].Type)
+	}
+	op.RemoveParam("fields", "query").RespondsWith(200, nil).WithDefaultResponse(nil)
+	assert.Len(t, op.Parameters, 1)
+	assert.Empty(t, op.Responses.StatusCodeResponses)
+	assert.Nil(t, op.Responses.Default)
+}
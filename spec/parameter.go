@@ -7,6 +7,13 @@ import (
 	"github.com/casualjim/go-swagger/swag"
 )
 
+// ParamRef creates a parameter that's a json reference
+func ParamRef(uri string) *Parameter {
+	p := new(Parameter)
+	p.Ref = MustCreateRef(uri)
+	return p
+}
+
 // QueryParam creates a query parameter
 func QueryParam(name string) *Parameter {
 	return &Parameter{paramProps: paramProps{Name: name, In: "query"}}
@@ -96,6 +103,24 @@ func (p Parameter) JSONLookup(token string) (interface{}, error) {
 	return r, err
 }
 
+// Named a fluent builder method to override the name of the parameter
+func (p *Parameter) Named(name string) *Parameter {
+	p.Name = name
+	return p
+}
+
+// WithDescription a fluent builder method for the description of the parameter
+func (p *Parameter) WithDescription(description string) *Parameter {
+	p.Description = description
+	return p
+}
+
+// WithLocation a fluent builder method to override the location of the parameter
+func (p *Parameter) WithLocation(in string) *Parameter {
+	p.In = in
+	return p
+}
+
 // Typed a fluent builder method for the type of parameter
 func (p *Parameter) Typed(tpe, format string) *Parameter {
 	p.Type = tpe
@@ -0,0 +1,234 @@
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/casualjim/go-swagger/jsonpointer"
+	"github.com/casualjim/go-swagger/swag"
+	"gopkg.in/yaml.v2"
+)
+
+// PatchOperation is a single operation of a JSON patch document
+//
+// For more information: https://tools.ietf.org/html/rfc6902
+type PatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	From  string      `json:"from,omitempty"`
+	Value interface{} `json:"value"`
+}
+
+// MarshalJSON converts the operation to JSON, the value is only left out of the operations
+// that don't take one, so a null, false, 0 or empty value of the other operations is kept
+func (p PatchOperation) MarshalJSON() ([]byte, error) {
+	switch p.Op {
+	case "remove", "move", "copy":
+		return json.Marshal(struct {
+			Op   string `json:"op"`
+			Path string `json:"path"`
+			From string `json:"from,omitempty"`
+		}{Op: p.Op, Path: p.Path, From: p.From})
+	}
+	type patchOperation PatchOperation
+	return json.Marshal(patchOperation(p))
+}
+
+// Patch is a JSON patch document, the operations are applied in order
+type Patch []PatchOperation
+
+// LoadPatch loads a JSON patch document from a json or yaml file or url
+func LoadPatch(path string) (Patch, error) {
+	b, err := swag.LoadFromFileOrHTTP(path)
+	if err != nil {
+		return nil, err
+	}
+	// yaml is a superset of json, so this reads both
+	var doc interface{}
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+	data, err := swag.YAMLToJSON(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	var patch Patch
+	if err := json.Unmarshal(data, &patch); err != nil {
+		return nil, err
+	}
+	return patch, nil
+}
+
+// ApplyPatch applies the operations of the JSON patch to this spec.
+// When an operation fails the spec is left unchanged.
+func (s *Swagger) ApplyPatch(patch Patch) error {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	var doc interface{}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return err
+	}
+
+	for i, op := range patch {
+		doc, err = applyPatchOperation(doc, op)
+		if err != nil {
+			return fmt.Errorf("patch operation %d (%s %s): %v", i, op.Op, op.Path, err)
+		}
+	}
+
+	b, err = json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	patched := new(Swagger)
+	if err := json.Unmarshal(b, patched); err != nil {
+		return err
+	}
+	*s = *patched
+	return nil
+}
+
+func applyPatchOperation(doc interface{}, op PatchOperation) (interface{}, error) {
+	ptr, err := jsonpointer.New(op.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.Op {
+	case "add":
+		return patchAdd(doc, ptr, op.Value)
+
+	case "remove":
+		return ptr.Delete(doc)
+
+	case "replace":
+		if ptr.IsEmpty() {
+			return op.Value, nil
+		}
+		doc, err = ptr.Delete(doc)
+		if err != nil {
+			return nil, err
+		}
+		return patchAdd(doc, ptr, op.Value)
+
+	case "move", "copy":
+		from, err := jsonpointer.New(op.From)
+		if err != nil {
+			return nil, err
+		}
+		value, err := patchLookup(doc, from.DecodedTokens())
+		if err != nil {
+			return nil, err
+		}
+		if op.Op == "move" {
+			if strings.HasPrefix(op.Path, op.From+"/") {
+				return nil, fmt.Errorf("can't move %q into one of its children", op.From)
+			}
+			doc, err = from.Delete(doc)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			// the copy can't share maps or slices with the original
+			b, err := json.Marshal(value)
+			if err != nil {
+				return nil, err
+			}
+			if err := json.Unmarshal(b, &value); err != nil {
+				return nil, err
+			}
+		}
+		return patchAdd(doc, ptr, value)
+
+	case "test":
+		value, err := patchLookup(doc, ptr.DecodedTokens())
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(value, op.Value) {
+			return nil, fmt.Errorf("the value is %v instead of %v", value, op.Value)
+		}
+		return doc, nil
+
+	default:
+		return nil, fmt.Errorf("unknown operation %q", op.Op)
+	}
+}
+
+// patchAdd adds the value at the pointer, unlike setting a value with a pointer
+// an index in an array inserts the value before the element at that index
+func patchAdd(doc interface{}, ptr jsonpointer.Pointer, value interface{}) (interface{}, error) {
+	tokens := ptr.DecodedTokens()
+	if len(tokens) == 0 {
+		return value, nil
+	}
+
+	parentTokens, last := tokens[:len(tokens)-1], tokens[len(tokens)-1]
+	parent, err := patchLookup(doc, parentTokens)
+	if err != nil {
+		return nil, err
+	}
+	arr, ok := parent.([]interface{})
+	if !ok || last == "-" {
+		return ptr.Set(doc, value)
+	}
+
+	index, err := strconv.Atoi(last)
+	if err != nil {
+		return nil, err
+	}
+	if index < 0 || index > len(arr) {
+		return nil, fmt.Errorf("index out of bounds array[0,%d] index '%d'", len(arr), index)
+	}
+	inserted := make([]interface{}, 0, len(arr)+1)
+	inserted = append(inserted, arr[:index]...)
+	inserted = append(inserted, value)
+	inserted = append(inserted, arr[index:]...)
+
+	if len(parentTokens) == 0 {
+		return inserted, nil
+	}
+	escaped := make([]string, len(parentTokens))
+	for i, token := range parentTokens {
+		escaped[i] = jsonpointer.Escape(token)
+	}
+	parentPtr, err := jsonpointer.New("/" + strings.Join(escaped, "/"))
+	if err != nil {
+		return nil, err
+	}
+	return parentPtr.Set(doc, inserted)
+}
+
+// patchLookup gets the value for the tokens in a generic json document,
+// unlike getting a value with a pointer zero values like false and 0 are found too
+func patchLookup(doc interface{}, tokens []string) (interface{}, error) {
+	node := doc
+	for _, token := range tokens {
+		switch nd := node.(type) {
+		case map[string]interface{}:
+			value, ok := nd[token]
+			if !ok {
+				return nil, fmt.Errorf("object has no key %q", token)
+			}
+			node = value
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil {
+				return nil, err
+			}
+			if index < 0 || index >= len(nd) {
+				return nil, fmt.Errorf("index out of bounds array[0,%d] index '%d'", len(nd), index)
+			}
+			node = nd[index]
+		default:
+			return nil, fmt.Errorf("invalid token reference %q", token)
+		}
+	}
+	return node, nil
+}
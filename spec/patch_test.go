@@ -0,0 +1,124 @@
+package spec
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const patchBaseSpec = `{
+	"swagger": "2.0",
+	"info": {"title": "Petstore", "version": "1.0.0"},
+	"host": "localhost:8080",
+	"schemes": ["http"],
+	"tags": [{"name": "pets"}],
+	"paths": {
+		"/pets": {"get": {"operationId": "listPets", "responses": {"200": {"description": "the pets"}}}},
+		"/debug": {"get": {"operationId": "debug", "responses": {"200": {"description": "debug info"}}}}
+	}
+}`
+
+func patchSpec(t testing.TB) *Swagger {
+	sp := new(Swagger)
+	if err := json.Unmarshal([]byte(patchBaseSpec), sp); err != nil {
+		t.Fatal(err)
+	}
+	return sp
+}
+
+func TestApplyPatch(t *testing.T) {
+	var patch Patch
+	err := json.Unmarshal([]byte(`[
+		{"op": "test", "path": "/host", "value": "localhost:8080"},
+		{"op": "replace", "path": "/host", "value": "api.example.com"},
+		{"op": "add", "path": "/schemes/0", "value": "https"},
+		{"op": "remove", "path": "/schemes/1"},
+		{"op": "add", "path": "/securityDefinitions", "value": {"api_key": {"type": "apiKey", "name": "X-API-Key", "in": "header"}}},
+		{"op": "add", "path": "/security", "value": [{"api_key": []}]},
+		{"op": "add", "path": "/tags/-", "value": {"name": "store"}},
+		{"op": "remove", "path": "/paths/~1debug"},
+		{"op": "copy", "from": "/paths/~1pets/get", "path": "/paths/~1pets/head"},
+		{"op": "move", "from": "/info/title", "path": "/info/description"},
+		{"op": "add", "path": "/info/title", "value": "Petstore (production)"},
+		{"op": "add", "path": "/x-environment", "value": "production"}
+	]`), &patch)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	sp := patchSpec(t)
+	if assert.NoError(t, sp.ApplyPatch(patch)) {
+		assert.Equal(t, "api.example.com", sp.Host)
+		assert.Equal(t, []string{"https"}, sp.Schemes)
+		if assert.Contains(t, sp.SecurityDefinitions, "api_key") {
+			assert.Equal(t, "X-API-Key", sp.SecurityDefinitions["api_key"].Name)
+		}
+		assert.Equal(t, []map[string][]string{{"api_key": []string{}}}, sp.Security)
+		if assert.Len(t, sp.Tags, 2) {
+			assert.Equal(t, "store", sp.Tags[1].Name)
+		}
+		assert.NotContains(t, sp.Paths.Paths, "/debug")
+		if assert.NotNil(t, sp.Paths.Paths["/pets"].Head) {
+			assert.Equal(t, "listPets", sp.Paths.Paths["/pets"].Head.ID)
+		}
+		assert.Equal(t, "Petstore", sp.Info.Description)
+		assert.Equal(t, "Petstore (production)", sp.Info.Title)
+		assert.Equal(t, "production", sp.Extensions["x-environment"])
+	}
+}
+
+func TestApplyPatch_Errors(t *testing.T) {
+	for _, patch := range []Patch{
+		{{Op: "test", Path: "/host", Value: "example.com"}},
+		{{Op: "remove", Path: "/basePath"}},
+		{{Op: "replace", Path: "/tags/3", Value: "store"}},
+		{{Op: "add", Path: "/tags/3", Value: map[string]interface{}{"name": "store"}}},
+		{{Op: "move", From: "/info", Path: "/info/title"}},
+		{{Op: "merge", Path: "/info"}},
+		{{Op: "add", Path: "info"}},
+	} {
+		sp := patchSpec(t)
+		err := sp.ApplyPatch(append(Patch{{Op: "replace", Path: "/host", Value: "api.example.com"}}, patch...))
+		assert.Error(t, err, "%v", patch)
+		// the spec doesn't change when the patch fails
+		assert.Equal(t, "localhost:8080", sp.Host)
+	}
+}
+
+func TestLoadPatch(t *testing.T) {
+	patch, err := LoadPatch("../fixtures/patch/production.yaml")
+	if assert.NoError(t, err) && assert.Len(t, patch, 2) {
+		assert.Equal(t, PatchOperation{Op: "replace", Path: "/host", Value: "api.example.com"}, patch[0])
+	}
+
+	sp := patchSpec(t)
+	if assert.NoError(t, sp.ApplyPatch(patch)) {
+		assert.Equal(t, "api.example.com", sp.Host)
+		assert.Equal(t, []string{"https"}, sp.Schemes)
+	}
+}
+
+func TestPatchOperation_MarshalJSON(t *testing.T) {
+	patch := Patch{
+		{Op: "add", Path: "/x-nothing", Value: nil},
+		{Op: "replace", Path: "/x-enabled", Value: false},
+		{Op: "test", Path: "/x-count", Value: 0},
+		{Op: "add", Path: "/x-name", Value: ""},
+		{Op: "remove", Path: "/host"},
+		{Op: "move", Path: "/x-to", From: "/x-from"},
+		{Op: "copy", Path: "/x-to", From: "/x-from"},
+	}
+	b, err := json.Marshal(patch)
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `[
+			{"op": "add", "path": "/x-nothing", "value": null},
+			{"op": "replace", "path": "/x-enabled", "value": false},
+			{"op": "test", "path": "/x-count", "value": 0},
+			{"op": "add", "path": "/x-name", "value": ""},
+			{"op": "remove", "path": "/host"},
+			{"op": "move", "path": "/x-to", "from": "/x-from"},
+			{"op": "copy", "path": "/x-to", "from": "/x-from"}
+		]`, string(b))
+	}
+}
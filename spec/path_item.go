@@ -74,3 +74,17 @@ func (p PathItem) MarshalJSON() ([]byte, error) {
 	concated := swag.ConcatJSON(b3, b4, b5)
 	return concated, nil
 }
+
+// Operations the operations of the path item by their lower case method, the values point into
+// the path item so an operation can be set or removed through them
+func (p *PathItem) Operations() map[string]**Operation {
+	return map[string]**Operation{
+		"get":     &p.Get,
+		"put":     &p.Put,
+		"post":    &p.Post,
+		"delete":  &p.Delete,
+		"options": &p.Options,
+		"head":    &p.Head,
+		"patch":   &p.Patch,
+	}
+}
@@ -96,3 +96,18 @@ func TestIntegrationPathItem(t *testing.T) {
 		})
 	})
 }
+
+func TestPathItemOperations(t *testing.T) {
+	Convey("the operations of a path item", t, func() {
+		var item PathItem
+		ops := item.Operations()
+		So(ops, ShouldHaveLength, 7)
+
+		get := &Operation{operationProps: operationProps{ID: "getPet"}}
+		*ops["get"] = get
+		So(item.Get, ShouldEqual, get)
+
+		*ops["get"] = nil
+		So(item.Get, ShouldBeNil)
+	})
+}
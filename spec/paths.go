@@ -30,6 +30,26 @@ func (p Paths) JSONLookup(token string) (interface{}, error) {
 	return nil, fmt.Errorf("object has no field %q", token)
 }
 
+// JSONSet sets a path item or an extension by the json property name, a nil value removes it
+func (p *Paths) JSONSet(token string, value interface{}) error {
+	if !strings.HasPrefix(token, "/") {
+		return p.vendorExtensible.JSONSet(token, value)
+	}
+	if value == nil {
+		delete(p.Paths, token)
+		return nil
+	}
+	var item PathItem
+	if err := setJSONValue(&item, value); err != nil {
+		return err
+	}
+	if p.Paths == nil {
+		p.Paths = make(map[string]PathItem)
+	}
+	p.Paths[token] = item
+	return nil
+}
+
 // UnmarshalJSON hydrates this items instance with the data from JSON
 func (p *Paths) UnmarshalJSON(data []byte) error {
 	var res map[string]json.RawMessage
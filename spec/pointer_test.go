@@ -0,0 +1,141 @@
+package spec
+
+import (
+	"testing"
+
+	"github.com/casualjim/go-swagger/jsonpointer"
+	"github.com/stretchr/testify/assert"
+)
+
+func pointerSpec() *Swagger {
+	sp := new(Swagger)
+	sp.Swagger = "2.0"
+	sp.Info = &Info{infoProps: infoProps{Title: "Petstore", Version: "1.0.0"}}
+	sp.AddOperation("get", "/pets", NewOperation("listPets").RespondsWith(200, NewResponse().WithDescription("the pets"))).
+		AddDefinition("pet", new(Schema).Typed("object", "").SetProperty("name", *StringProperty()))
+	return sp
+}
+
+func setPointer(t testing.TB, doc interface{}, pointer string, value interface{}) error {
+	ptr, err := jsonpointer.New(pointer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ptr.Set(doc, value)
+	return err
+}
+
+func deletePointer(t testing.TB, doc interface{}, pointer string) error {
+	ptr, err := jsonpointer.New(pointer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ptr.Delete(doc)
+	return err
+}
+
+func getPointer(t testing.TB, doc interface{}, pointer string) (interface{}, error) {
+	ptr, err := jsonpointer.New(pointer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, _, err := ptr.Get(doc)
+	return value, err
+}
+
+func TestPointerSetExtensions(t *testing.T) {
+	sp := pointerSpec()
+	for _, pointer := range []string{
+		"/x-foo",
+		"/info/x-foo",
+		"/paths/x-foo",
+		"/paths/~1pets/x-foo",
+		"/paths/~1pets/get/x-foo",
+		"/paths/~1pets/get/responses/x-foo",
+		"/paths/~1pets/get/responses/200/x-foo",
+		"/definitions/pet/x-foo",
+		"/definitions/pet/properties/name/x-foo",
+	} {
+		if assert.NoError(t, setPointer(t, sp, pointer, "bar"), pointer) {
+			value, err := getPointer(t, sp, pointer)
+			if assert.NoError(t, err, pointer) {
+				assert.Equal(t, "bar", *value.(*interface{}), pointer)
+			}
+		}
+	}
+	assert.Equal(t, "bar", sp.Extensions["x-foo"])
+	assert.Equal(t, "bar", sp.Paths.Paths["/pets"].Get.Responses.StatusCodeResponses[200].Extensions["x-foo"])
+	assert.Equal(t, "bar", sp.Definitions["pet"].Properties["name"].Extensions["x-foo"])
+
+	assert.NoError(t, deletePointer(t, sp, "/paths/~1pets/get/x-foo"))
+	assert.NotContains(t, sp.Paths.Paths["/pets"].Get.Extensions, "x-foo")
+	assert.NoError(t, deletePointer(t, sp, "/definitions/pet/x-foo"))
+	assert.NotContains(t, sp.Definitions["pet"].Extensions, "x-foo")
+
+	// only extensions can be added to the objects of the spec
+	assert.Error(t, setPointer(t, sp, "/info/foo", "bar"))
+	assert.Error(t, setPointer(t, sp, "/paths/~1pets/get/foo", "bar"))
+}
+
+func TestPointerSetPaths(t *testing.T) {
+	sp := pointerSpec()
+
+	item := PathItem{}
+	item.Post = NewOperation("createPet")
+	assert.NoError(t, setPointer(t, sp, "/paths/~1pets~1{id}", item))
+	assert.Equal(t, "createPet", sp.Paths.Paths["/pets/{id}"].Post.ID)
+
+	// generic json, like the value of a patch, gets converted
+	assert.NoError(t, setPointer(t, sp, "/paths/~1stores", map[string]interface{}{
+		"get": map[string]interface{}{"operationId": "listStores"},
+	}))
+	assert.Equal(t, "listStores", sp.Paths.Paths["/stores"].Get.ID)
+
+	assert.NoError(t, setPointer(t, sp, "/paths/~1pets/get/operationId", "findPets"))
+	assert.Equal(t, "findPets", sp.Paths.Paths["/pets"].Get.ID)
+
+	assert.NoError(t, deletePointer(t, sp, "/paths/~1stores"))
+	assert.NotContains(t, sp.Paths.Paths, "/stores")
+}
+
+func TestPointerSetResponses(t *testing.T) {
+	sp := pointerSpec()
+
+	assert.NoError(t, setPointer(t, sp, "/paths/~1pets/get/responses/200/description", "all the pets"))
+	assert.Equal(t, "all the pets", sp.Paths.Paths["/pets"].Get.Responses.StatusCodeResponses[200].Description)
+
+	assert.NoError(t, setPointer(t, sp, "/paths/~1pets/get/responses/default", map[string]interface{}{"description": "an error"}))
+	if assert.NotNil(t, sp.Paths.Paths["/pets"].Get.Responses.Default) {
+		assert.Equal(t, "an error", sp.Paths.Paths["/pets"].Get.Responses.Default.Description)
+	}
+	assert.NoError(t, setPointer(t, sp, "/paths/~1pets/get/responses/404", NewResponse().WithDescription("not found")))
+	assert.Equal(t, "not found", sp.Paths.Paths["/pets"].Get.Responses.StatusCodeResponses[404].Description)
+
+	assert.NoError(t, deletePointer(t, sp, "/paths/~1pets/get/responses/default"))
+	assert.Nil(t, sp.Paths.Paths["/pets"].Get.Responses.Default)
+	assert.NoError(t, deletePointer(t, sp, "/paths/~1pets/get/responses/404"))
+	assert.NotContains(t, sp.Paths.Paths["/pets"].Get.Responses.StatusCodeResponses, 404)
+}
+
+func TestPointerSetSchema(t *testing.T) {
+	sp := pointerSpec()
+
+	assert.NoError(t, setPointer(t, sp, "/definitions/pet/properties/age", *Int32Property()))
+	assert.Equal(t, "int32", sp.Definitions["pet"].Properties["age"].Format)
+
+	assert.NoError(t, setPointer(t, sp, "/definitions/pet/properties/tag", map[string]interface{}{"type": "string"}))
+	assert.True(t, sp.Definitions["pet"].Properties["tag"].Type.Contains("string"))
+
+	assert.NoError(t, setPointer(t, sp, "/definitions/pet/properties/name/minLength", 3))
+	if assert.NotNil(t, sp.Definitions["pet"].Properties["name"].MinLength) {
+		assert.EqualValues(t, 3, *sp.Definitions["pet"].Properties["name"].MinLength)
+	}
+
+	assert.NoError(t, setPointer(t, sp, "/definitions/pet/discriminatorValue", "pet"))
+	assert.Equal(t, "pet", sp.Definitions["pet"].ExtraProps["discriminatorValue"])
+
+	assert.NoError(t, deletePointer(t, sp, "/definitions/pet/properties/tag"))
+	assert.NotContains(t, sp.Definitions["pet"].Properties, "tag")
+	assert.NoError(t, deletePointer(t, sp, "/definitions/pet/discriminatorValue"))
+	assert.NotContains(t, sp.Definitions["pet"].ExtraProps, "discriminatorValue")
+}
@@ -43,6 +43,39 @@ func (r Responses) JSONLookup(token string) (interface{}, error) {
 	return nil, fmt.Errorf("object has no field %q", token)
 }
 
+// JSONSet sets a response by its status code or default, or an extension, a nil value removes it
+func (r *Responses) JSONSet(token string, value interface{}) error {
+	if token == "default" {
+		if value == nil {
+			r.Default = nil
+			return nil
+		}
+		rsp := new(Response)
+		if err := setJSONValue(rsp, value); err != nil {
+			return err
+		}
+		r.Default = rsp
+		return nil
+	}
+	code, err := strconv.Atoi(token)
+	if err != nil {
+		return r.vendorExtensible.JSONSet(token, value)
+	}
+	if value == nil {
+		delete(r.StatusCodeResponses, code)
+		return nil
+	}
+	var rsp Response
+	if err := setJSONValue(&rsp, value); err != nil {
+		return err
+	}
+	if r.StatusCodeResponses == nil {
+		r.StatusCodeResponses = make(map[int]Response)
+	}
+	r.StatusCodeResponses[code] = rsp
+	return nil
+}
+
 // UnmarshalJSON hydrates this items instance with the data from JSON
 func (r *Responses) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &r.responsesProps); err != nil {
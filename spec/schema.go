@@ -250,6 +250,64 @@ func (s Schema) JSONLookup(token string) (interface{}, error) {
 	return r, err
 }
 
+// JSONSet sets an extension or one of the extra properties by the json property name, a nil value removes it
+func (s *Schema) JSONSet(token string, value interface{}) error {
+	if strings.HasPrefix(strings.ToLower(token), "x-") {
+		return s.vendorExtensible.JSONSet(token, value)
+	}
+	if value == nil {
+		delete(s.ExtraProps, token)
+		return nil
+	}
+	if s.ExtraProps == nil {
+		s.ExtraProps = make(map[string]interface{})
+	}
+	s.ExtraProps[token] = value
+	return nil
+}
+
+// WithID sets the id for this schema, allows for chaining
+func (s *Schema) WithID(id string) *Schema {
+	s.ID = id
+	return s
+}
+
+// WithTitle sets the title for this schema, allows for chaining
+func (s *Schema) WithTitle(title string) *Schema {
+	s.Title = title
+	return s
+}
+
+// WithDescription sets the description for this schema, allows for chaining
+func (s *Schema) WithDescription(description string) *Schema {
+	s.Description = description
+	return s
+}
+
+// WithExample sets the example for this schema
+func (s *Schema) WithExample(example interface{}) *Schema {
+	s.Example = example
+	return s
+}
+
+// WithDiscriminator sets the name of the discriminator property for this schema
+func (s *Schema) WithDiscriminator(discriminator string) *Schema {
+	s.Discriminator = discriminator
+	return s
+}
+
+// AsReadOnly flags this schema as readonly
+func (s *Schema) AsReadOnly() *Schema {
+	s.ReadOnly = true
+	return s
+}
+
+// AsWritable flags this schema as writeable (not read-only)
+func (s *Schema) AsWritable() *Schema {
+	s.ReadOnly = false
+	return s
+}
+
 // WithProperties sets the properties for this schema
 func (s *Schema) WithProperties(schemas map[string]Schema) *Schema {
 	s.Properties = schemas
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 
 	"github.com/casualjim/go-swagger/jsonpointer"
 	"github.com/casualjim/go-swagger/swag"
@@ -15,9 +16,143 @@ import (
 //
 // For more information: http://goo.gl/8us55a#swagger-object-
 type Swagger struct {
+	vendorExtensible
 	swaggerProps
 }
 
+// AddOperation adds an operation for the method on the path to this spec, an operation that is
+// already there gets replaced and a nil operation removes it. The method is matched case insensitively,
+// an operation for a method that a path item has no field for (like trace) is dropped without an error
+func (s *Swagger) AddOperation(method, path string, operation *Operation) *Swagger {
+	if operation == nil {
+		return s.RemoveOperation(method, path)
+	}
+	if s.Paths == nil {
+		s.Paths = new(Paths)
+	}
+	if s.Paths.Paths == nil {
+		s.Paths.Paths = make(map[string]PathItem)
+	}
+
+	item := s.Paths.Paths[path]
+	ptr, ok := item.Operations()[strings.ToLower(method)]
+	if !ok {
+		return s
+	}
+	*ptr = operation
+	s.Paths.Paths[path] = item
+	return s
+}
+
+// RemoveOperation removes the operation for the method on the path from this spec
+func (s *Swagger) RemoveOperation(method, path string) *Swagger {
+	if s.Paths == nil {
+		return s
+	}
+	item, ok := s.Paths.Paths[path]
+	if !ok {
+		return s
+	}
+	if ptr, ok := item.Operations()[strings.ToLower(method)]; ok {
+		*ptr = nil
+	}
+	s.Paths.Paths[path] = item
+	return s
+}
+
+// AddDefinition adds a schema to the definitions of this spec, a nil schema removes it
+func (s *Swagger) AddDefinition(name string, schema *Schema) *Swagger {
+	if schema == nil {
+		return s.RemoveDefinition(name)
+	}
+	if s.Definitions == nil {
+		s.Definitions = make(Definitions)
+	}
+	s.Definitions[name] = *schema
+	return s
+}
+
+// RemoveDefinition removes a definition from the definitions of this spec
+func (s *Swagger) RemoveDefinition(name string) *Swagger {
+	delete(s.Definitions, name)
+	return s
+}
+
+// AddParameter adds a parameter to the parameters of this spec that operations can refer to, a nil param removes it
+func (s *Swagger) AddParameter(name string, param *Parameter) *Swagger {
+	if param == nil {
+		return s.RemoveParameter(name)
+	}
+	if s.Parameters == nil {
+		s.Parameters = make(map[string]Parameter)
+	}
+	s.Parameters[name] = *param
+	return s
+}
+
+// RemoveParameter removes a parameter from the parameters of this spec
+func (s *Swagger) RemoveParameter(name string) *Swagger {
+	delete(s.Parameters, name)
+	return s
+}
+
+// AddResponse adds a response to the responses of this spec that operations can refer to, a nil response removes it
+func (s *Swagger) AddResponse(name string, response *Response) *Swagger {
+	if response == nil {
+		return s.RemoveResponse(name)
+	}
+	if s.Responses == nil {
+		s.Responses = make(map[string]Response)
+	}
+	s.Responses[name] = *response
+	return s
+}
+
+// RemoveResponse removes a response from the responses of this spec
+func (s *Swagger) RemoveResponse(name string) *Swagger {
+	delete(s.Responses, name)
+	return s
+}
+
+// AddSecurityDefinition adds a security scheme to the security definitions of this spec, a nil scheme removes it
+func (s *Swagger) AddSecurityDefinition(name string, scheme *SecurityScheme) *Swagger {
+	if scheme == nil {
+		return s.RemoveSecurityDefinition(name)
+	}
+	if s.SecurityDefinitions == nil {
+		s.SecurityDefinitions = make(SecurityDefinitions)
+	}
+	s.SecurityDefinitions[name] = scheme
+	return s
+}
+
+// RemoveSecurityDefinition removes a security scheme from the security definitions of this spec
+func (s *Swagger) RemoveSecurityDefinition(name string) *Swagger {
+	delete(s.SecurityDefinitions, name)
+	return s
+}
+
+// AddTag adds a tag to this spec, a tag with the same name gets replaced
+func (s *Swagger) AddTag(tag Tag) *Swagger {
+	for i, t := range s.Tags {
+		if t.Name == tag.Name {
+			s.Tags[i] = tag
+			return s
+		}
+	}
+	s.Tags = append(s.Tags, tag)
+	return s
+}
+
+// JSONLookup look up a value by the json property name
+func (s Swagger) JSONLookup(token string) (interface{}, error) {
+	if ex, ok := s.Extensions[token]; ok {
+		return &ex, nil
+	}
+	r, _, err := jsonpointer.GetForToken(s.swaggerProps, token)
+	return r, err
+}
+
 const schemaJSONString = `{"$schema":"http://swagger.io/v2/schema.json#"}`
 
 var schemaJSONBytes = []byte(schemaJSONString)
@@ -28,7 +163,11 @@ func (s Swagger) MarshalJSON() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	return swag.ConcatJSON(schemaJSONBytes, b1), nil
+	b2, err := json.Marshal(s.vendorExtensible)
+	if err != nil {
+		return nil, err
+	}
+	return swag.ConcatJSON(schemaJSONBytes, b1, b2), nil
 }
 
 // UnmarshalJSON unmarshals a swagger spec from json
@@ -37,6 +176,9 @@ func (s *Swagger) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &sw.swaggerProps); err != nil {
 		return err
 	}
+	if err := json.Unmarshal(data, &sw.vendorExtensible); err != nil {
+		return err
+	}
 	*s = sw
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
 )
 
 func IsZero(data reflect.Value) bool {
@@ -203,3 +204,70 @@ func TestIntegrationSpec(t *testing.T) {
 		})
 	})
 }
+
+func TestSwaggerBuilder(t *testing.T) {
+	sp := new(Swagger)
+	sp.Swagger = "2.0"
+	sp.AddOperation("GET", "/pets/{id}", NewOperation("getPet").WithPathParam("id", "integer", "int64")).
+		AddOperation("delete", "/pets/{id}", NewOperation("deletePet")).
+		AddOperation("trace", "/pets/{id}", NewOperation("tracePet")).
+		AddDefinition("pet", new(Schema).Typed("object", "").SetProperty("name", *StringProperty().WithPattern("^[a-z]+$"))).
+		AddParameter("limit", QueryParam("limit").Typed("integer", "int32")).
+		AddResponse("error", NewResponse().WithDescription("an error").AddHeader("X-Request-Id", ResponseHeader().Typed("string", ""))).
+		AddSecurityDefinition("api_key", APIKeyAuth("X-API-Key", "header")).
+		AddTag(NewTag("pets", "", nil)).
+		AddTag(NewTag("pets", "everything about pets", nil))
+
+	item := sp.Paths.Paths["/pets/{id}"]
+	if assert.NotNil(t, item.Get) && assert.NotNil(t, item.Delete) {
+		assert.Equal(t, "getPet", item.Get.ID)
+		assert.Equal(t, "deletePet", item.Delete.ID)
+	}
+	assert.Equal(t, "^[a-z]+$", sp.Definitions["pet"].Properties["name"].Pattern)
+	assert.Equal(t, "integer", sp.Parameters["limit"].Type)
+	assert.Equal(t, "string", sp.Responses["error"].Headers["X-Request-Id"].Type)
+	assert.Equal(t, "header", sp.SecurityDefinitions["api_key"].In)
+	if assert.Len(t, sp.Tags, 1) {
+		assert.Equal(t, "everything about pets", sp.Tags[0].Description)
+	}
+
+	assert.Nil(t, item.Options)
+	assert.Nil(t, item.Head)
+
+	sp.RemoveOperation("get", "/pets/{id}")
+	item = sp.Paths.Paths["/pets/{id}"]
+	assert.Nil(t, item.Get)
+	assert.NotNil(t, item.Delete)
+}
+
+func TestSwaggerBuilder_Nil(t *testing.T) {
+	sp := new(Swagger)
+	assert.NotPanics(t, func() {
+		sp.AddOperation("get", "/pets", nil).
+			AddDefinition("pet", nil).
+			AddParameter("limit", nil).
+			AddResponse("error", nil).
+			AddSecurityDefinition("api_key", nil)
+	})
+	assert.Nil(t, sp.Paths)
+	assert.Empty(t, sp.Definitions)
+	assert.Empty(t, sp.Parameters)
+	assert.Empty(t, sp.Responses)
+	assert.Empty(t, sp.SecurityDefinitions)
+
+	sp.AddOperation("get", "/pets", NewOperation("listPets")).
+		AddDefinition("pet", new(Schema).Typed("object", "")).
+		AddParameter("limit", QueryParam("limit").Typed("integer", "int32")).
+		AddResponse("error", NewResponse().WithDescription("an error")).
+		AddSecurityDefinition("api_key", APIKeyAuth("X-API-Key", "header"))
+	sp.AddOperation("GET", "/pets", nil).
+		AddDefinition("pet", nil).
+		AddParameter("limit", nil).
+		AddResponse("error", nil).
+		AddSecurityDefinition("api_key", nil)
+	assert.Nil(t, sp.Paths.Paths["/pets"].Get)
+	assert.Empty(t, sp.Definitions)
+	assert.Empty(t, sp.Parameters)
+	assert.Empty(t, sp.Responses)
+	assert.Empty(t, sp.SecurityDefinitions)
+}
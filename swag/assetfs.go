@@ -6,7 +6,6 @@
 package swag
 
 import (
-	"fmt"
 	"net/http"
 	"strings"
 
@@ -22,7 +21,6 @@ func MiddlewareAt(basePath string, assetFS func() *assetfs.AssetFS, next http.Ha
 
 	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
 		if strings.HasPrefix(r.URL.Path, basePath) {
-			fmt.Println("serving url", r.URL)
 			fileServer.ServeHTTP(rw, r)
 			return
 		}
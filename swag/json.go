@@ -132,12 +132,18 @@ func buildnameIndex(tpe reflect.Type, idx, reverseIdx map[string]string) {
 	for i := 0; i < tpe.NumField(); i++ {
 		targetDes := tpe.Field(i)
 
-		if targetDes.PkgPath != "" { // unexported
+		if targetDes.Anonymous { // walk embedded structures tree down first, unexported ones can have exported fields
+			embedded := targetDes.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				buildnameIndex(embedded, idx, reverseIdx)
+			}
 			continue
 		}
 
-		if targetDes.Anonymous { // walk embedded structures tree down first
-			buildnameIndex(targetDes.Type, idx, reverseIdx)
+		if targetDes.PkgPath != "" { // unexported
 			continue
 		}
 
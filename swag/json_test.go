@@ -14,6 +14,24 @@ type testNameStruct struct {
 	Ignored    string `json:"-"`
 }
 
+type testEmbeddedProps struct {
+	Description string `json:"description"`
+}
+
+type testEmbeddingStruct struct {
+	testEmbeddedProps
+	Title string `json:"title"`
+}
+
+func TestNameProviderUnexportedEmbedded(t *testing.T) {
+	provider := NewNameProvider()
+
+	nm, ok := provider.GetGoName(testEmbeddingStruct{}, "description")
+	assert.True(t, ok)
+	assert.Equal(t, "Description", nm)
+	assert.Len(t, provider.GetJSONNames(testEmbeddingStruct{}), 2)
+}
+
 func TestNameProvider(t *testing.T) {
 
 	provider := NewNameProvider()
@@ -0,0 +1,84 @@
+package swag
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Fields represents a set of structured key value pairs that get attached to a log entry
+type Fields map[string]interface{}
+
+// Logger represents the logging facade used throughout the toolkit.
+// It is kept deliberately small so it can be adapted to most logging libraries.
+type Logger interface {
+	// Printf writes a formatted log entry, including the fields attached to this logger
+	Printf(format string, args ...interface{})
+	// WithFields returns a logger that will attach the provided fields to every entry
+	WithFields(fields Fields) Logger
+}
+
+// NewLogger creates a logger that writes entries to the provided standard library logger.
+// Fields are rendered as key=value pairs, sorted by key, after the message.
+func NewLogger(l *log.Logger) Logger {
+	if l == nil {
+		l = log.New(os.Stderr, "", log.LstdFlags)
+	}
+	return &stdLogger{out: l}
+}
+
+// DefaultLogger creates a logger that writes to standard error
+func DefaultLogger() Logger {
+	return NewLogger(nil)
+}
+
+// WriterLogger creates a logger that writes to the provided writer
+func WriterLogger(w io.Writer) Logger {
+	return NewLogger(log.New(w, "", log.LstdFlags))
+}
+
+// DiscardLogger creates a logger that drops every entry
+func DiscardLogger() Logger {
+	return WriterLogger(ioutil.Discard)
+}
+
+type stdLogger struct {
+	out    *log.Logger
+	fields Fields
+}
+
+func (s *stdLogger) Printf(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	if len(s.fields) == 0 {
+		s.out.Println(msg)
+		return
+	}
+
+	keys := make([]string, 0, len(s.fields))
+	for k := range s.fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys)+1)
+	parts = append(parts, msg)
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s=%v", k, s.fields[k]))
+	}
+	s.out.Println(strings.Join(parts, " "))
+}
+
+func (s *stdLogger) WithFields(fields Fields) Logger {
+	merged := make(Fields, len(s.fields)+len(fields))
+	for k, v := range s.fields {
+		merged[k] = v
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+	return &stdLogger{out: s.out, fields: merged}
+}
@@ -0,0 +1,32 @@
+package swag
+
+import (
+	"bytes"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoggerFields(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLogger(log.New(&buf, "", 0))
+
+	logger.Printf("hello %s", "world")
+	assert.Equal(t, "hello world\n", buf.String())
+
+	buf.Reset()
+	scoped := logger.WithFields(Fields{"status": 200, "operation": "getPet"})
+	scoped.Printf("handled")
+	assert.Equal(t, "handled operation=getPet status=200\n", buf.String())
+
+	buf.Reset()
+	scoped.WithFields(Fields{"status": 404}).Printf("handled")
+	assert.Equal(t, "handled operation=getPet status=404\n", buf.String())
+
+	// the parent logger is not affected by derived loggers
+	buf.Reset()
+	logger.Printf("plain")
+	assert.False(t, strings.Contains(buf.String(), "status"))
+}
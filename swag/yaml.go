@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"gopkg.in/yaml.v2"
 )
 
 // YAMLToJSON converts YAML unmarshaled data into json compatible data
@@ -16,6 +18,15 @@ func YAMLToJSON(data interface{}) (json.RawMessage, error) {
 	return json.RawMessage(b), err
 }
 
+// JSONToYAML converts a json document into yaml, the keys keep the order they have in the json document
+func JSONToYAML(data []byte) ([]byte, error) {
+	var document yaml.MapSlice
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(document)
+}
+
 func transformData(in interface{}) (out interface{}, err error) {
 	switch in.(type) {
 	case map[interface{}]interface{}:
@@ -41,6 +41,15 @@ func TestLoadHTTPBytes(t *testing.T) {
 	assert.Equal(t, []byte("the content"), d)
 }
 
+func TestJSONToYAML(t *testing.T) {
+	d, err := JSONToYAML([]byte(`{"swagger":"2.0","info":{"version":"1.0.0","title":"yes"},"paths":{"/b":{},"/a":{}},"responses":{"200":{"description":"ok"}}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "swagger: \"2.0\"\ninfo:\n  version: 1.0.0\n  title: \"yes\"\npaths:\n  /b: {}\n  /a: {}\nresponses:\n  \"200\":\n    description: ok\n", string(d))
+
+	_, err = JSONToYAML([]byte(`{"swagger":`))
+	assert.Error(t, err)
+}
+
 func TestYAMLToJSON(t *testing.T) {
 
 	data := make(map[interface{}]interface{})